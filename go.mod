@@ -7,4 +7,5 @@ require (
 	github.com/google/go-querystring v1.1.0
 	github.com/gorilla/websocket v1.4.2
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,92 @@
+// Package testutil provides fixtures shared by the tests in this module
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// KeyPairPEM returns a PEM encoded self signed certificate and key, like the private certificates chia generates
+func KeyPairPEM(t testing.TB) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "Chia"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// KeyPair returns a self signed certificate and key
+func KeyPair(t testing.TB) *tls.Certificate {
+	certPEM, keyPEM := KeyPairPEM(t)
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &keyPair
+}
+
+// WriteKeyPair writes a self signed certificate and key to certPath and keyPath, creating any missing directories
+func WriteKeyPair(t testing.TB, certPath string, keyPath string) {
+	certPEM, keyPEM := KeyPairPEM(t)
+
+	for p, data := range map[string][]byte{certPath: certPEM, keyPath: keyPEM} {
+		err := os.MkdirAll(filepath.Dir(p), 0700)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(p, data, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// ServerAddress returns the host and port the test server is listening on
+// Clients take the host in the base URL and the port per service, so they are returned separately
+func ServerAddress(t testing.TB, server *httptest.Server) (string, uint16) {
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, port, err := net.SplitHostPort(serverURL.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return host, uint16(p)
+}
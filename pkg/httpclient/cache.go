@@ -28,6 +28,16 @@ func NewCachedTransport(expiration time.Duration, transport http.RoundTripper) *
 	}
 }
 
+// CloseIdleConnections closes idle connections on the wrapped transport, if it supports it
+func (c *CachedTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if tr, ok := c.originalTransport.(closeIdler); ok {
+		tr.CloseIdleConnections()
+	}
+}
+
 // key returns the cache key for the request
 func (c *CachedTransport) key(r *http.Request) string {
 	method := r.Method
@@ -49,9 +59,12 @@ func (c *CachedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 	cacheKey := c.key(r)
 
 	// If the response is cached, we can just respond with the cached version
-	if cached, found := c.cache.Get(cacheKey); found != false {
-		cachedBytes := cached.([]byte)
-		return c.cachedResponse(cachedBytes, r)
+	// Requests with Cache-Control: no-cache always go to the server, and refresh the cache with the response
+	if r.Header.Get("Cache-Control") != "no-cache" {
+		if cached, found := c.cache.Get(cacheKey); found != false {
+			cachedBytes := cached.([]byte)
+			return c.cachedResponse(cachedBytes, r)
+		}
 	}
 
 	resp, err := c.originalTransport.RoundTrip(r)
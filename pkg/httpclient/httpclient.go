@@ -2,12 +2,15 @@ package httpclient
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/cmmarslender/go-chia-lib/pkg/config"
@@ -18,43 +21,37 @@ import (
 
 // HTTPClient connects to Chia RPC via standard HTTP requests
 type HTTPClient struct {
-	config  *config.ChiaConfig
 	baseURL *url.URL
 
 	// If set > 0, will configure http requests with a cache
 	cacheValidTime time.Duration
 
-	nodePort    uint16
-	nodeKeyPair *tls.Certificate
-	nodeClient  *http.Client
+	// timeout is applied to the context of every request. 0 disables the timeout, leaving only the request context
+	timeout time.Duration
 
-	farmerPort    uint16
-	farmerKeyPair *tls.Certificate
-	farmerClient  *http.Client
+	// tlsVerification configures how the server certificate is verified
+	tlsVerification rpcinterface.TLSVerification
 
-	harvesterPort    uint16
-	harvesterKeyPair *tls.Certificate
-	harvesterClient  *http.Client
+	// credentials provides the port and key pair for each service, loading them the first time they're needed
+	credentials rpcinterface.ServiceCredentials
 
-	walletPort    uint16
-	walletKeyPair *tls.Certificate
-	walletClient  *http.Client
+	// clients holds the http client for each service that has been used
+	clients map[rpcinterface.ServiceType]*http.Client
 
-	crawlerPort    uint16
-	crawlerKeyPair *tls.Certificate
-	crawlerClient  *http.Client
+	// lock guards timeout, tlsVerification and clients
+	lock sync.Mutex
 }
 
 // NewHTTPClient returns a new HTTP client that satisfies the rpcinterface.Client interface
+// If cfg is nil, the chia config is loaded from CHIA_ROOT the first time a port or key pair that wasn't set with an
+// option is needed
 func NewHTTPClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOptionFunc) (*HTTPClient, error) {
 	c := &HTTPClient{
-		config: cfg,
-
-		nodePort:      cfg.FullNode.RPCPort,
-		farmerPort:    cfg.Farmer.RPCPort,
-		harvesterPort: cfg.Harvester.RPCPort,
-		walletPort:    cfg.Wallet.RPCPort,
-		crawlerPort:   cfg.Seeder.CrawlerConfig.RPCPort,
+		timeout: 10 * time.Second,
+		clients: map[rpcinterface.ServiceType]*http.Client{},
+	}
+	if cfg != nil {
+		c.credentials.SetConfig(cfg, "")
 	}
 
 	// Sets the default host. Can be overridden by client options
@@ -66,11 +63,6 @@ func NewHTTPClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOptionF
 		return nil, err
 	}
 
-	err = c.initialKeyPairs()
-	if err != nil {
-		return nil, err
-	}
-
 	for _, fn := range options {
 		if fn == nil {
 			continue
@@ -80,12 +72,6 @@ func NewHTTPClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOptionF
 		}
 	}
 
-	// Generate the http clients and transports after any client options are applied, in case custom keypairs were provided
-	err = c.generateHTTPClients()
-	if err != nil {
-		return nil, err
-	}
-
 	return c, nil
 }
 
@@ -96,20 +82,79 @@ func (c *HTTPClient) SetBaseURL(url *url.URL) error {
 	return nil
 }
 
+// SetCAPool verifies the server certificate was issued by one of the CAs in the pool
+// Requests after this use new connections that are verified, once requests already in flight finish
+func (c *HTTPClient) SetCAPool(pool *x509.CertPool) {
+	c.updateVerification(func(v *rpcinterface.TLSVerification) {
+		v.CAPool = pool
+	})
+}
+
+// AddServerFingerprint pins the server certificate to a SHA-256 fingerprint
+// Requests after this use new connections that are verified, once requests already in flight finish
+func (c *HTTPClient) AddServerFingerprint(fingerprint []byte) {
+	c.updateVerification(func(v *rpcinterface.TLSVerification) {
+		v.Fingerprints = append(v.Fingerprints, fingerprint)
+	})
+}
+
+// updateVerification changes how the server certificate is verified
+// The service http clients were built with the old verification, so they are discarded and rebuilt when next used
+func (c *HTTPClient) updateVerification(update func(v *rpcinterface.TLSVerification)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	update(&c.tlsVerification)
+	for _, client := range c.clients {
+		client.CloseIdleConnections()
+	}
+	c.clients = map[rpcinterface.ServiceType]*http.Client{}
+}
+
+// SetConfig sets the chia config that ports and key pairs are read from, when not set for the service directly
+// Relative SSL paths in the config are relative to rootPath, or CHIA_ROOT if rootPath is empty
+func (c *HTTPClient) SetConfig(cfg *config.ChiaConfig, rootPath string) {
+	c.credentials.SetConfig(cfg, rootPath)
+}
+
+// SetServicePort sets the port for the service, instead of reading it from the chia config
+func (c *HTTPClient) SetServicePort(service rpcinterface.ServiceType, port uint16) {
+	c.credentials.SetPort(service, port)
+}
+
+// SetServiceKeyPair sets the key pair for the service, instead of loading it from the paths in the chia config
+func (c *HTTPClient) SetServiceKeyPair(service rpcinterface.ServiceType, keyPair *tls.Certificate) {
+	c.credentials.SetKeyPair(service, keyPair)
+}
+
 // SetCacheValidTime sets how long cache should be valid for
 func (c *HTTPClient) SetCacheValidTime(validTime time.Duration) {
 	c.cacheValidTime = validTime
 }
 
+// SetTimeout sets the maximum time a single request may take
+// Set to 0 to rely solely on the context passed to DoWithContext. Applies to requests made after it is called
+func (c *HTTPClient) SetTimeout(timeout time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.timeout = timeout
+}
+
 // NewRequest creates an RPC request for the specified service
 func (c *HTTPClient) NewRequest(service rpcinterface.ServiceType, rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
 	// Always POST
 	// Supporting it as a variable in case that changes in the future, it can be passed in instead
 	method := http.MethodPost
 
+	port, err := c.credentials.Port(service)
+	if err != nil {
+		return nil, err
+	}
+
 	u := *c.baseURL
 
-	u.Host = fmt.Sprintf("%s:%d", u.Host, c.portForService(service))
+	u.Host = fmt.Sprintf("%s:%d", u.Host, port)
 
 	u.RawPath = fmt.Sprintf("/%s", rpcEndpoint)
 	u.Path = fmt.Sprintf("/%s", rpcEndpoint)
@@ -119,7 +164,6 @@ func (c *HTTPClient) NewRequest(service rpcinterface.ServiceType, rpcEndpoint rp
 	reqHeaders.Set("Accept", "application/json")
 
 	var body []byte
-	var err error
 	switch {
 	case method == http.MethodPost || method == http.MethodPut:
 		reqHeaders.Set("Content-Type", "application/json")
@@ -152,132 +196,93 @@ func (c *HTTPClient) NewRequest(service rpcinterface.ServiceType, rpcEndpoint rp
 	}
 
 	return &rpcinterface.Request{
-		Service: service,
-		Request: req,
+		Service:  service,
+		Endpoint: rpcEndpoint,
+		Request:  req,
 	}, nil
 }
 
 // Do sends an RPC request and returns the RPC response.
 func (c *HTTPClient) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
-	client, err := c.httpClientForService(req.Service)
-	if err != nil {
-		return nil, err
-	}
+	return c.DoWithContext(context.Background(), req, v)
+}
 
-	resp, err := client.Do(req.Request)
+// DoWithContext sends an RPC request and returns the RPC response.
+// The request is aborted if ctx is cancelled or its deadline passes before the response is read
+func (c *HTTPClient) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	client, err := c.httpClientForService(req.Service)
 	if err != nil {
 		return nil, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-		}
-	}
-
-	return resp, err
-}
-
-// Sets the initial key pairs based on config
-func (c *HTTPClient) initialKeyPairs() error {
-	var err error
-
-	c.nodeKeyPair, err = c.config.FullNode.SSL.LoadPrivateKeyPair()
-	if err != nil {
-		return err
+	c.lock.Lock()
+	timeout := c.timeout
+	c.lock.Unlock()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	c.farmerKeyPair, err = c.config.Farmer.SSL.LoadPrivateKeyPair()
-	if err != nil {
-		return err
+	httpReq := req.Request.WithContext(ctx)
+	if req.NoCache {
+		httpReq.Header = httpReq.Header.Clone()
+		httpReq.Header.Set("Cache-Control", "no-cache")
 	}
 
-	c.harvesterKeyPair, err = c.config.Harvester.SSL.LoadPrivateKeyPair()
+	resp, err := client.Do(httpReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	c.walletKeyPair, err = c.config.Wallet.SSL.LoadPrivateKeyPair()
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return resp, err
 	}
+	// Leave the body readable for callers that want to inspect the raw response
+	resp.Body = io.NopCloser(bytes.NewReader(body))
 
-	c.crawlerKeyPair, err = c.config.Seeder.CrawlerConfig.SSL.LoadPrivateKeyPair()
+	err = rpcinterface.CheckResponse(req.Service, req.Endpoint, resp.StatusCode, body)
 	if err != nil {
-		return err
+		return resp, err
 	}
 
-	return nil
-}
-
-func (c *HTTPClient) generateHTTPClients() error {
-	var err error
-
-	if c.nodeClient == nil {
-		c.nodeClient, err = c.generateHTTPClientForService(rpcinterface.ServiceFullNode)
-		if err != nil {
-			return err
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = w.Write(body)
+		} else {
+			err = json.Unmarshal(body, v)
 		}
 	}
 
-	if c.farmerClient == nil {
-		c.farmerClient, err = c.generateHTTPClientForService(rpcinterface.ServiceFarmer)
-		if err != nil {
-			return err
-		}
-	}
+	return resp, err
+}
 
-	if c.harvesterClient == nil {
-		c.harvesterClient, err = c.generateHTTPClientForService(rpcinterface.ServiceHarvester)
-		if err != nil {
-			return err
-		}
+// httpClientForService returns the http client to use with the service
+// The client is created the first time the service is used, so only services that are used need a key pair
+func (c *HTTPClient) httpClientForService(service rpcinterface.ServiceType) (*http.Client, error) {
+	// The daemon only accepts websocket connections
+	if service == rpcinterface.ServiceDaemon {
+		return nil, fmt.Errorf("service %s is not available over http", service)
 	}
 
-	if c.walletClient == nil {
-		c.walletClient, err = c.generateHTTPClientForService(rpcinterface.ServiceWallet)
-		if err != nil {
-			return err
-		}
-	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
 
-	if c.crawlerClient == nil {
-		c.crawlerClient, err = c.generateHTTPClientForService(rpcinterface.ServiceCrawler)
-		if err != nil {
-			return err
-		}
+	if client, ok := c.clients[service]; ok {
+		return client, nil
 	}
 
-	return nil
-}
-
-func (c *HTTPClient) generateHTTPClientForService(service rpcinterface.ServiceType) (*http.Client, error) {
-	var keyPair *tls.Certificate
-
-	switch service {
-	case rpcinterface.ServiceFullNode:
-		keyPair = c.nodeKeyPair
-	case rpcinterface.ServiceFarmer:
-		keyPair = c.farmerKeyPair
-	case rpcinterface.ServiceHarvester:
-		keyPair = c.harvesterKeyPair
-	case rpcinterface.ServiceWallet:
-		keyPair = c.walletKeyPair
-	case rpcinterface.ServiceCrawler:
-		keyPair = c.crawlerKeyPair
-	default:
-		return nil, fmt.Errorf("unknown service")
+	keyPair, err := c.credentials.KeyPair(service)
+	if err != nil {
+		return nil, err
 	}
 
 	var transport http.RoundTripper
 
 	transport = &http.Transport{
-		TLSClientConfig: &tls.Config{
-			Certificates:       []tls.Certificate{*keyPair},
-			InsecureSkipVerify: true, // Cert is apparently for chia.net - can't validate until it matches hostname
-		},
+		TLSClientConfig: c.tlsVerification.TLSConfig(keyPair),
 	}
 
 	if c.cacheValidTime > 0 {
@@ -286,54 +291,23 @@ func (c *HTTPClient) generateHTTPClientForService(service rpcinterface.ServiceTy
 
 	client := &http.Client{
 		Transport: transport,
-		Timeout:   10 * time.Second,
 	}
+	c.clients[service] = client
 
 	return client, nil
 }
 
-// portForService returns the configured port for the service
-func (c *HTTPClient) portForService(service rpcinterface.ServiceType) uint16 {
-	var port uint16 = 0
-
-	switch service {
-	case rpcinterface.ServiceFullNode:
-		port = c.nodePort
-	case rpcinterface.ServiceFarmer:
-		port = c.farmerPort
-	case rpcinterface.ServiceHarvester:
-		port = c.harvesterPort
-	case rpcinterface.ServiceWallet:
-		port = c.walletPort
-	case rpcinterface.ServiceCrawler:
-		port = c.crawlerPort
-	}
-
-	return port
-}
-
-// httpClientForService returns the proper http client to use with the service
-func (c *HTTPClient) httpClientForService(service rpcinterface.ServiceType) (*http.Client, error) {
-	var client *http.Client
-
-	switch service {
-	case rpcinterface.ServiceFullNode:
-		client = c.nodeClient
-	case rpcinterface.ServiceFarmer:
-		client = c.farmerClient
-	case rpcinterface.ServiceHarvester:
-		client = c.harvesterClient
-	case rpcinterface.ServiceWallet:
-		client = c.walletClient
-	case rpcinterface.ServiceCrawler:
-		client = c.crawlerClient
-	}
+// Close closes any idle connections held by the service http clients
+// Requests can still be made after Close, and will open new connections
+func (c *HTTPClient) Close(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
 
-	if client == nil {
-		return nil, fmt.Errorf("unknown service")
+	for _, client := range c.clients {
+		client.CloseIdleConnections()
 	}
 
-	return client, nil
+	return nil
 }
 
 // The following are here to satisfy the interface, but are not used by the HTTP client
@@ -0,0 +1,134 @@
+package httpclient_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/internal/testutil"
+	"github.com/cmmarslender/go-chia-rpc/pkg/httpclient"
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+)
+
+// newTestServer returns a server that answers every request with success, except /hang which is never answered
+func newTestServer(t *testing.T) *httptest.Server {
+	release := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/hang" {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": true}`))
+	}))
+	// Cleanups run last in first out, so hanging requests are released before the server waits for them
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	return server
+}
+
+// newTestClient returns a client that sends full node requests to the server
+func newTestClient(t *testing.T, server *httptest.Server) *httpclient.HTTPClient {
+	host, port := testutil.ServerAddress(t, server)
+
+	client, err := httpclient.NewHTTPClient(nil, func(c rpcinterface.Client) error {
+		c.SetServicePort(rpcinterface.ServiceFullNode, port)
+		c.SetServiceKeyPair(rpcinterface.ServiceFullNode, testutil.KeyPair(t))
+		return c.SetBaseURL(&url.URL{Scheme: "https", Host: host})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func doRequest(ctx context.Context, c *httpclient.HTTPClient, endpoint rpcinterface.Endpoint) error {
+	request, err := c.NewRequest(rpcinterface.ServiceFullNode, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoWithContext(ctx, request, nil)
+	return err
+}
+
+func TestDoWithContextCancel(t *testing.T) {
+	c := newTestClient(t, newTestServer(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := doRequest(ctx, c, "hang")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be aborted when the context was cancelled, took %s", elapsed)
+	}
+}
+
+func TestSetTimeoutAfterFirstRequest(t *testing.T) {
+	c := newTestClient(t, newTestServer(t))
+
+	// The first request creates the full node http client, so the timeout has to apply to clients that already exist
+	if err := doRequest(context.Background(), c, "get_blockchain_state"); err != nil {
+		t.Fatal(err)
+	}
+
+	c.SetTimeout(50 * time.Millisecond)
+	err := doRequest(context.Background(), c, "hang")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestServerVerificationAfterFirstRequest(t *testing.T) {
+	server := newTestServer(t)
+	c := newTestClient(t, server)
+
+	// The first request creates the full node http client, and leaves an idle connection that wasn't verified
+	if err := doRequest(context.Background(), c, "get_blockchain_state"); err != nil {
+		t.Fatal(err)
+	}
+
+	wrongFingerprint := sha256.Sum256([]byte("not the server certificate"))
+	c.AddServerFingerprint(wrongFingerprint[:])
+	if err := doRequest(context.Background(), c, "get_blockchain_state"); err == nil {
+		t.Fatal("expected requests after pinning another certificate to be rejected")
+	}
+}
+
+func TestServerVerificationDuringRequests(t *testing.T) {
+	server := newTestServer(t)
+	c := newTestClient(t, server)
+	fingerprint := sha256.Sum256(server.Certificate().Raw)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				if err := doRequest(context.Background(), c, "get_blockchain_state"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 5; i++ {
+		c.AddServerFingerprint(fingerprint[:])
+	}
+	wg.Wait()
+}
@@ -1,28 +1,33 @@
 package rpc
 
 import (
+	"context"
 	"net/http"
-
-	"github.com/cmmarslender/go-chia-lib/pkg/config"
+	"sync"
 
 	"github.com/cmmarslender/go-chia-rpc/pkg/httpclient"
 	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
-	"github.com/cmmarslender/go-chia-rpc/pkg/types"
 	"github.com/cmmarslender/go-chia-rpc/pkg/websocketclient"
 )
 
 // Client is the RPC client
 type Client struct {
-	config *config.ChiaConfig
-
 	activeClient rpcinterface.Client
 
 	// Services for the different chia services
-	FullNodeService *FullNodeService
-	WalletService   *WalletService
-	CrawlerService  *CrawlerService
-
-	websocketHandlers []rpcinterface.WebsocketResponseHandler
+	DaemonService    *DaemonService
+	FullNodeService  *FullNodeService
+	WalletService    *WalletService
+	FarmerService    *FarmerService
+	HarvesterService *HarvesterService
+	CrawlerService   *CrawlerService
+	DataLayerService *DataLayerService
+
+	broker eventBroker
+	events eventDispatcher
+
+	// handlers tracks the goroutines running AddHandler and typed event handlers, so Close can wait for them
+	handlers sync.WaitGroup
 }
 
 // ConnectionMode specifies the method used to connect to the server (HTTP or Websocket)
@@ -37,22 +42,18 @@ const (
 )
 
 // NewClient returns a new RPC Client
+// The chia config is read from CHIA_ROOT the first time a port or key pair is needed that wasn't provided with an option,
+// so only the services that are actually used need credentials
 func NewClient(connectionMode ConnectionMode, options ...rpcinterface.ClientOptionFunc) (*Client, error) {
-	cfg, err := config.GetChiaConfig()
-	if err != nil {
-		return nil, err
-	}
-
-	c := &Client{
-		config: cfg,
-	}
+	c := &Client{}
 
 	var activeClient rpcinterface.Client
+	var err error
 	switch connectionMode {
 	case ConnectionModeHTTP:
-		activeClient, err = httpclient.NewHTTPClient(cfg, options...)
+		activeClient, err = httpclient.NewHTTPClient(nil, options...)
 	case ConnectionModeWebsocket:
-		activeClient, err = websocketclient.NewWebsocketClient(cfg, options...)
+		activeClient, err = websocketclient.NewWebsocketClient(nil, options...)
 	}
 	if err != nil {
 		return nil, err
@@ -60,9 +61,13 @@ func NewClient(connectionMode ConnectionMode, options ...rpcinterface.ClientOpti
 	c.activeClient = activeClient
 
 	// Init Services
+	c.DaemonService = &DaemonService{client: c}
 	c.FullNodeService = &FullNodeService{client: c}
 	c.WalletService = &WalletService{client: c}
+	c.FarmerService = &FarmerService{client: c}
+	c.HarvesterService = &HarvesterService{client: c}
 	c.CrawlerService = &CrawlerService{client: c}
+	c.DataLayerService = &DataLayerService{client: c}
 
 	return c, nil
 }
@@ -77,6 +82,50 @@ func (c *Client) Do(req *rpcinterface.Request, v interface{}) (*http.Response, e
 	return c.activeClient.Do(req, v)
 }
 
+// DoWithContext is a helper that wraps the activeClient's DoWithContext method
+func (c *Client) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return c.activeClient.DoWithContext(ctx, req, v)
+}
+
+// Close closes the connection to chia and waits for every event stream to end, and for handlers registered with
+// AddHandler or the typed On* methods to return. A handler that calls Close waits until ctx is done
+// In websocket mode subscriptions are unregistered first, and requests waiting on a response fail
+// In HTTP mode idle connections are closed
+func (c *Client) Close(ctx context.Context) error {
+	err := c.activeClient.Close(ctx)
+
+	c.broker.lock.RLock()
+	listening := c.broker.listening
+	stopped := c.broker.stopped
+	c.broker.lock.RUnlock()
+
+	if listening {
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			return err
+		}
+	}
+
+	handlersDone := make(chan struct{})
+	go func() {
+		c.handlers.Wait()
+		close(handlersDone)
+	}()
+	select {
+	case <-handlersDone:
+	case <-ctx.Done():
+		if err == nil {
+			err = ctx.Err()
+		}
+	}
+
+	return err
+}
+
 // The following has a bunch of methods that are currently only used for the websocket implementation
 
 // SubscribeSelf subscribes to responses to requests from this service
@@ -91,26 +140,60 @@ func (c *Client) Subscribe(service string) error {
 	return c.activeClient.Subscribe(service)
 }
 
-// AddHandler adds a handler function to call when a message is received over the websocket
-// This is expected to NOT be used in conjunction with ListenSync
-// This will run in the background, and allow other things to happen in the foreground
-// while ListenSync will take over the foreground process
-func (c *Client) AddHandler(handler rpcinterface.WebsocketResponseHandler) error {
-	c.websocketHandlers = append(c.websocketHandlers, handler)
+// OnDisconnect registers a function that is called when the websocket connection is lost
+// This is currently only useful for websocket mode
+func (c *Client) OnDisconnect(handler func(err error)) {
+	if wsClient, ok := c.activeClient.(*websocketclient.WebsocketClient); ok {
+		wsClient.OnDisconnect(handler)
+	}
+}
 
-	go c.ListenSync(c.handlerProxy)
-	return nil
+// OnReconnect registers a function that is called once a lost websocket connection is reestablished
+// Events sent while disconnected are missed, so this is a good place to refresh any state kept from events
+// This is currently only useful for websocket mode
+func (c *Client) OnReconnect(handler func()) {
+	if wsClient, ok := c.activeClient.(*websocketclient.WebsocketClient); ok {
+		wsClient.OnReconnect(handler)
+	}
 }
 
-// handlerProxy matches the websocketRespHandler signature to send requests back to any registered handlers
-// Here to support multiple handlers for a single event in the future
-func (c *Client) handlerProxy(resp *types.WebsocketResponse, err error) {
-	for _, handler := range c.websocketHandlers {
-		handler(resp, err)
+// AddHandler adds a handler function to call when a message is received over the websocket
+// Each handler runs in its own goroutine, fed by an event stream, so it never holds up reading the connection or
+// other handlers, and can make requests over the same connection. If a handler falls a full buffer of events
+// behind, the oldest buffered events are dropped, and the handler is called with a nil response and a
+// *DroppedEventsError before the next event. Use Events directly for control over buffering
+func (c *Client) AddHandler(handler rpcinterface.WebsocketResponseHandler) error {
+	stream, err := c.Events(context.Background(), EventFilter{}, WithOverflowPolicy(OverflowDropOldest))
+	if err != nil {
+		return err
 	}
+
+	c.handlers.Add(1)
+	go func() {
+		defer c.handlers.Done()
+		var reported uint64
+		for event := range stream.C {
+			if err := stream.droppedSince(&reported); err != nil {
+				handler(nil, err)
+			}
+			handler(event.Raw, event.Err)
+		}
+	}()
+
+	return nil
 }
 
 // ListenSync Listens for async responses over the connection in a synchronous fashion, blocking anything else
+// Returns when listening on the connection stops, with the error that stopped it
 func (c *Client) ListenSync(handler rpcinterface.WebsocketResponseHandler) error {
-	return c.activeClient.ListenSync(handler)
+	stream, err := c.Events(context.Background(), EventFilter{})
+	if err != nil {
+		return err
+	}
+
+	for event := range stream.C {
+		handler(event.Raw, event.Err)
+	}
+
+	return stream.Err()
 }
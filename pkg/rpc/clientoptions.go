@@ -1,9 +1,20 @@
 package rpc
 
 import (
-	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/url"
+	"path/filepath"
 	"time"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/config"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/websocketclient"
 )
 
 // WithBaseURL sets the host for RPC requests
@@ -22,3 +33,160 @@ func WithCache(validTime time.Duration) rpcinterface.ClientOptionFunc {
 		return nil
 	}
 }
+
+// WithTimeout sets the maximum time a single HTTP request may take
+// Defaults to 10 seconds. Set to 0 to disable, and rely on the context passed to the *WithContext methods instead
+func WithTimeout(timeout time.Duration) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		c.SetTimeout(timeout)
+
+		return nil
+	}
+}
+
+// ErrWebsocketOnlyOption is returned from NewClient when an option that only applies to websockets is used in
+// another connection mode
+// Websocket only settings are not part of rpcinterface.Client, so other implementations don't need to support them
+var ErrWebsocketOnlyOption = errors.New("option is only supported in websocket mode")
+
+// WithReconnectPolicy sets how the websocket client reconnects when the connection is lost
+// Defaults to websocketclient.DefaultReconnectPolicy(). Returns ErrWebsocketOnlyOption in HTTP mode
+func WithReconnectPolicy(policy websocketclient.ReconnectPolicy) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		wsClient, ok := c.(*websocketclient.WebsocketClient)
+		if !ok {
+			return fmt.Errorf("WithReconnectPolicy: %w", ErrWebsocketOnlyOption)
+		}
+		wsClient.SetReconnectPolicy(policy)
+
+		return nil
+	}
+}
+
+// WithKeepalive sets how often the websocket client pings the server, and how long it waits for a response
+// before treating the connection as dead and reconnecting
+// Defaults to websocketclient.DefaultKeepalive(). Returns ErrWebsocketOnlyOption in HTTP mode
+func WithKeepalive(keepalive websocketclient.Keepalive) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		wsClient, ok := c.(*websocketclient.WebsocketClient)
+		if !ok {
+			return fmt.Errorf("WithKeepalive: %w", ErrWebsocketOnlyOption)
+		}
+		wsClient.SetKeepalive(keepalive)
+
+		return nil
+	}
+}
+
+// WithCAPool verifies the server certificate was issued by one of the CAs in the pool
+// Chia certificates are issued for chia.net rather than the host, so the certificate is verified against chia.net
+func WithCAPool(pool *x509.CertPool) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		c.SetCAPool(pool)
+
+		return nil
+	}
+}
+
+// WithCAFile verifies the server certificate was issued by the CA in the PEM file
+// For chia, this is the node's config/ssl/ca/private_ca.crt
+func WithCAFile(path string) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", path)
+		}
+		c.SetCAPool(pool)
+
+		return nil
+	}
+}
+
+// WithServerFingerprint pins the server certificate to its hex SHA-256 fingerprint
+// May be used more than once to accept any of several certificates
+func WithServerFingerprint(fingerprint string) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		b, err := rpcinterface.ParseFingerprint(fingerprint)
+		if err != nil {
+			return err
+		}
+		c.AddServerFingerprint(b)
+
+		return nil
+	}
+}
+
+// WithConfig reads ports and key pairs from cfg instead of the config in CHIA_ROOT
+// Relative SSL paths in the config are relative to rootPath, or CHIA_ROOT if rootPath is empty
+func WithConfig(cfg *config.ChiaConfig, rootPath string) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		c.SetConfig(cfg, rootPath)
+
+		return nil
+	}
+}
+
+// WithConfigPath reads ports and key pairs from the config.yaml at path instead of the config in CHIA_ROOT
+// Relative SSL paths in the config are relative to the chia root the config is in, the parent of its config directory
+func WithConfigPath(path string) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		configBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		cfg := &config.ChiaConfig{}
+		err = yaml.Unmarshal(configBytes, cfg)
+		if err != nil {
+			return fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+		c.SetConfig(cfg, filepath.Dir(filepath.Dir(path)))
+
+		return nil
+	}
+}
+
+// WithServicePort sets the port for the service, instead of reading it from the chia config
+// In websocket mode, only the daemon port is used
+func WithServicePort(service rpcinterface.ServiceType, port uint16) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		c.SetServicePort(service, port)
+
+		return nil
+	}
+}
+
+// WithServiceKeyPair sets the PEM encoded private cert and key for the service, instead of loading them from the
+// paths in the chia config
+// In websocket mode, only the daemon key pair is used
+func WithServiceKeyPair(service rpcinterface.ServiceType, certPEM []byte, keyPEM []byte) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("invalid key pair for %s: %w", service, err)
+		}
+		c.SetServiceKeyPair(service, &keyPair)
+
+		return nil
+	}
+}
+
+// WithServiceKeyPairFiles loads the private cert and key for the service from the files, instead of the paths in the
+// chia config
+// In websocket mode, only the daemon key pair is used
+func WithServiceKeyPairFiles(service rpcinterface.ServiceType, certPath string, keyPath string) rpcinterface.ClientOptionFunc {
+	return func(c rpcinterface.Client) error {
+		keyPair, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return fmt.Errorf("unable to load key pair for %s: %w", service, err)
+		}
+		c.SetServiceKeyPair(service, &keyPair)
+
+		return nil
+	}
+}
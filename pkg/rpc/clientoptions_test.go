@@ -0,0 +1,24 @@
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/websocketclient"
+)
+
+func TestWebsocketOnlyOptions(t *testing.T) {
+	_, err := NewClient(ConnectionModeHTTP, WithKeepalive(websocketclient.DefaultKeepalive()))
+	if !errors.Is(err, ErrWebsocketOnlyOption) {
+		t.Errorf("expected ErrWebsocketOnlyOption for WithKeepalive in HTTP mode, got %v", err)
+	}
+	_, err = NewClient(ConnectionModeHTTP, WithReconnectPolicy(websocketclient.DefaultReconnectPolicy()))
+	if !errors.Is(err, ErrWebsocketOnlyOption) {
+		t.Errorf("expected ErrWebsocketOnlyOption for WithReconnectPolicy in HTTP mode, got %v", err)
+	}
+
+	_, err = NewClient(ConnectionModeWebsocket, WithKeepalive(websocketclient.DefaultKeepalive()), WithReconnectPolicy(websocketclient.DefaultReconnectPolicy()))
+	if err != nil {
+		t.Errorf("expected websocket only options to be accepted in websocket mode, got %v", err)
+	}
+}
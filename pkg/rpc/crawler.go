@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
@@ -22,21 +23,31 @@ func (s *CrawlerService) Do(req *rpcinterface.Request, v interface{}) (*http.Res
 	return s.client.Do(req, v)
 }
 
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *CrawlerService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
 // GetPeerCountsResponse Response for get_get_peer_counts on crawler
 type GetPeerCountsResponse struct {
-	Success    bool                     `json:"success"`
+	rpcinterface.Response
 	PeerCounts *types.CrawlerPeerCounts `json:"peer_counts"`
 }
 
 // GetPeerCounts crawler rpc -> get_peer_counts
 func (s *CrawlerService) GetPeerCounts() (*GetPeerCountsResponse, *http.Response, error) {
+	return s.GetPeerCountsWithContext(context.Background())
+}
+
+// GetPeerCountsWithContext is the same as GetPeerCounts, but the request is bound to ctx
+func (s *CrawlerService) GetPeerCountsWithContext(ctx context.Context) (*GetPeerCountsResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_peer_counts", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetPeerCountsResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -53,20 +64,25 @@ type GetIPsAfterTimestampOptions struct {
 
 // GetIPsAfterTimestampResponse Response for get_ips_after_timestamp
 type GetIPsAfterTimestampResponse struct {
-	Success bool     `json:"success"`
-	IPs     []string `json:"ips"`
-	Total   int      `json:"total"`
+	rpcinterface.Response
+	IPs   []string `json:"ips"`
+	Total int      `json:"total"`
 }
 
 // GetIPsAfterTimestamp Returns IP addresses seen by the network after a particular timestamp
 func (s *CrawlerService) GetIPsAfterTimestamp(opts *GetIPsAfterTimestampOptions) (*GetIPsAfterTimestampResponse, *http.Response, error) {
+	return s.GetIPsAfterTimestampWithContext(context.Background(), opts)
+}
+
+// GetIPsAfterTimestampWithContext is the same as GetIPsAfterTimestamp, but the request is bound to ctx
+func (s *CrawlerService) GetIPsAfterTimestampWithContext(ctx context.Context, opts *GetIPsAfterTimestampOptions) (*GetIPsAfterTimestampResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_ips_after_timestamp", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetIPsAfterTimestampResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,423 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// DaemonService encapsulates daemon RPC methods
+// The daemon is only reachable over the websocket, so these methods require ConnectionModeWebsocket
+type DaemonService struct {
+	client *Client
+}
+
+// NewRequest returns a new request specific to the daemon service
+func (s *DaemonService) NewRequest(rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
+	return s.client.NewRequest(rpcinterface.ServiceDaemon, rpcEndpoint, opt)
+}
+
+// Do is just a shortcut to the client's Do method
+func (s *DaemonService) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.Do(req, v)
+}
+
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *DaemonService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
+// ServiceOptions options for the daemon calls that operate on a single service
+// start_service, stop_service and is_running
+// Service is the name the daemon uses for the service, such as chia_full_node or chia_wallet
+type ServiceOptions struct {
+	Service string `json:"service"`
+}
+
+// StartServiceResponse response from start_service
+type StartServiceResponse struct {
+	rpcinterface.Response
+	Service string `json:"service"`
+}
+
+// StartService daemon rpc -> start_service
+func (s *DaemonService) StartService(opts *ServiceOptions) (*StartServiceResponse, *http.Response, error) {
+	return s.StartServiceWithContext(context.Background(), opts)
+}
+
+// StartServiceWithContext is the same as StartService, but the request is bound to ctx
+func (s *DaemonService) StartServiceWithContext(ctx context.Context, opts *ServiceOptions) (*StartServiceResponse, *http.Response, error) {
+	request, err := s.NewRequest("start_service", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &StartServiceResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// StopServiceResponse response from stop_service
+type StopServiceResponse struct {
+	rpcinterface.Response
+	ServiceName string `json:"service_name"`
+}
+
+// StopService daemon rpc -> stop_service
+func (s *DaemonService) StopService(opts *ServiceOptions) (*StopServiceResponse, *http.Response, error) {
+	return s.StopServiceWithContext(context.Background(), opts)
+}
+
+// StopServiceWithContext is the same as StopService, but the request is bound to ctx
+func (s *DaemonService) StopServiceWithContext(ctx context.Context, opts *ServiceOptions) (*StopServiceResponse, *http.Response, error) {
+	request, err := s.NewRequest("stop_service", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &StopServiceResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// IsRunningResponse response from is_running
+type IsRunningResponse struct {
+	rpcinterface.Response
+	ServiceName string `json:"service_name"`
+	IsRunning   bool   `json:"is_running"`
+}
+
+// IsRunning daemon rpc -> is_running
+func (s *DaemonService) IsRunning(opts *ServiceOptions) (*IsRunningResponse, *http.Response, error) {
+	return s.IsRunningWithContext(context.Background(), opts)
+}
+
+// IsRunningWithContext is the same as IsRunning, but the request is bound to ctx
+func (s *DaemonService) IsRunningWithContext(ctx context.Context, opts *ServiceOptions) (*IsRunningResponse, *http.Response, error) {
+	request, err := s.NewRequest("is_running", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &IsRunningResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// RunningServicesResponse response from running_services
+type RunningServicesResponse struct {
+	rpcinterface.Response
+	RunningServices []string `json:"running_services"`
+}
+
+// RunningServices daemon rpc -> running_services
+func (s *DaemonService) RunningServices() (*RunningServicesResponse, *http.Response, error) {
+	return s.RunningServicesWithContext(context.Background())
+}
+
+// RunningServicesWithContext is the same as RunningServices, but the request is bound to ctx
+func (s *DaemonService) RunningServicesWithContext(ctx context.Context) (*RunningServicesResponse, *http.Response, error) {
+	request, err := s.NewRequest("running_services", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &RunningServicesResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetStatusResponse response from get_status
+type GetStatusResponse struct {
+	rpcinterface.Response
+	GenesisInitialized bool `json:"genesis_initialized"`
+}
+
+// GetStatus daemon rpc -> get_status
+func (s *DaemonService) GetStatus() (*GetStatusResponse, *http.Response, error) {
+	return s.GetStatusWithContext(context.Background())
+}
+
+// GetStatusWithContext is the same as GetStatus, but the request is bound to ctx
+func (s *DaemonService) GetStatusWithContext(ctx context.Context) (*GetStatusResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_status", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetStatusResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// Exit daemon rpc -> exit stops all services and the daemon itself
+func (s *DaemonService) Exit() (*EmptyResponse, *http.Response, error) {
+	return s.ExitWithContext(context.Background())
+}
+
+// ExitWithContext is the same as Exit, but the request is bound to ctx
+func (s *DaemonService) ExitWithContext(ctx context.Context) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("exit", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// IsKeyringLockedResponse response from is_keyring_locked
+type IsKeyringLockedResponse struct {
+	rpcinterface.Response
+	IsKeyringLocked bool `json:"is_keyring_locked"`
+}
+
+// IsKeyringLocked daemon rpc -> is_keyring_locked
+func (s *DaemonService) IsKeyringLocked() (*IsKeyringLockedResponse, *http.Response, error) {
+	return s.IsKeyringLockedWithContext(context.Background())
+}
+
+// IsKeyringLockedWithContext is the same as IsKeyringLocked, but the request is bound to ctx
+func (s *DaemonService) IsKeyringLockedWithContext(ctx context.Context) (*IsKeyringLockedResponse, *http.Response, error) {
+	request, err := s.NewRequest("is_keyring_locked", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &IsKeyringLockedResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// UnlockKeyringOptions options for unlock_keyring
+type UnlockKeyringOptions struct {
+	Key string `json:"key"`
+}
+
+// UnlockKeyring daemon rpc -> unlock_keyring unlocks the keyring with the passphrase
+func (s *DaemonService) UnlockKeyring(opts *UnlockKeyringOptions) (*EmptyResponse, *http.Response, error) {
+	return s.UnlockKeyringWithContext(context.Background(), opts)
+}
+
+// UnlockKeyringWithContext is the same as UnlockKeyring, but the request is bound to ctx
+func (s *DaemonService) UnlockKeyringWithContext(ctx context.Context, opts *UnlockKeyringOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("unlock_keyring", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetKeyForFingerprintOptions options for get_key_for_fingerprint
+// If Fingerprint is not set, the first key in the keychain is returned
+type GetKeyForFingerprintOptions struct {
+	Fingerprint uint32 `json:"fingerprint,omitempty"`
+}
+
+// GetKeyForFingerprintResponse response from get_key_for_fingerprint
+type GetKeyForFingerprintResponse struct {
+	rpcinterface.Response
+	PK      types.G1Element `json:"pk"`
+	Entropy string          `json:"entropy"`
+}
+
+// GetKeyForFingerprint daemon rpc -> get_key_for_fingerprint
+func (s *DaemonService) GetKeyForFingerprint(opts *GetKeyForFingerprintOptions) (*GetKeyForFingerprintResponse, *http.Response, error) {
+	return s.GetKeyForFingerprintWithContext(context.Background(), opts)
+}
+
+// GetKeyForFingerprintWithContext is the same as GetKeyForFingerprint, but the request is bound to ctx
+func (s *DaemonService) GetKeyForFingerprintWithContext(ctx context.Context, opts *GetKeyForFingerprintOptions) (*GetKeyForFingerprintResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_key_for_fingerprint", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetKeyForFingerprintResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetKeychainKeysOptions options for get_keys
+type GetKeychainKeysOptions struct {
+	IncludeSecrets bool `json:"include_secrets"`
+}
+
+// GetKeychainKeysResponse response from get_keys
+type GetKeychainKeysResponse struct {
+	rpcinterface.Response
+	Keys []*types.KeyData `json:"keys"`
+}
+
+// GetKeys daemon rpc -> get_keys
+func (s *DaemonService) GetKeys(opts *GetKeychainKeysOptions) (*GetKeychainKeysResponse, *http.Response, error) {
+	return s.GetKeysWithContext(context.Background(), opts)
+}
+
+// GetKeysWithContext is the same as GetKeys, but the request is bound to ctx
+func (s *DaemonService) GetKeysWithContext(ctx context.Context, opts *GetKeychainKeysOptions) (*GetKeychainKeysResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_keys", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetKeychainKeysResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// StartPlottingOptions options for start_plotting
+// The single letter json keys match the flags for `chia plots create`
+type StartPlottingOptions struct {
+	Plotter             string `json:"plotter,omitempty"` // chiapos, madmax or bladebit. Defaults to chiapos
+	K                   uint8  `json:"k"`
+	Count               uint32 `json:"n"`
+	Queue               string `json:"queue,omitempty"`
+	TmpDir              string `json:"t"`
+	Tmp2Dir             string `json:"t2,omitempty"`
+	FinalDir            string `json:"d"`
+	Buffer              uint32 `json:"b,omitempty"`
+	Buckets             uint32 `json:"u,omitempty"`
+	Threads             uint32 `json:"r,omitempty"`
+	Fingerprint         uint32 `json:"a,omitempty"`
+	FarmerPublicKey     string `json:"f,omitempty"`
+	PoolPublicKey       string `json:"p,omitempty"`
+	PoolContractAddress string `json:"c,omitempty"`
+	NoBitfield          bool   `json:"e"`
+	ExcludeFinalDir     bool   `json:"x"`
+	OverrideK           bool   `json:"overrideK"`
+	Parallel            bool   `json:"parallel"`
+	Delay               uint32 `json:"delay"`
+}
+
+// StartPlottingResponse response from start_plotting
+type StartPlottingResponse struct {
+	rpcinterface.Response
+	IDs []string `json:"ids"`
+}
+
+// StartPlotting daemon rpc -> start_plotting adds plots to the plotting queue
+func (s *DaemonService) StartPlotting(opts *StartPlottingOptions) (*StartPlottingResponse, *http.Response, error) {
+	return s.StartPlottingWithContext(context.Background(), opts)
+}
+
+// StartPlottingWithContext is the same as StartPlotting, but the request is bound to ctx
+func (s *DaemonService) StartPlottingWithContext(ctx context.Context, opts *StartPlottingOptions) (*StartPlottingResponse, *http.Response, error) {
+	request, err := s.NewRequest("start_plotting", struct {
+		Service string `json:"service"`
+		*StartPlottingOptions
+	}{
+		Service:              "chia_plotter",
+		StartPlottingOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &StartPlottingResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// StopPlottingOptions options for stop_plotting
+type StopPlottingOptions struct {
+	ID string `json:"id"`
+}
+
+// StopPlotting daemon rpc -> stop_plotting stops a plot that is in progress or removes it from the queue
+func (s *DaemonService) StopPlotting(opts *StopPlottingOptions) (*EmptyResponse, *http.Response, error) {
+	return s.StopPlottingWithContext(context.Background(), opts)
+}
+
+// StopPlottingWithContext is the same as StopPlotting, but the request is bound to ctx
+func (s *DaemonService) StopPlottingWithContext(ctx context.Context, opts *StopPlottingOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("stop_plotting", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPlottersResponse response from get_plotters
+type GetPlottersResponse struct {
+	rpcinterface.Response
+	Plotters map[string]*types.PlotterInfo `json:"plotters"`
+}
+
+// GetPlotters daemon rpc -> get_plotters
+func (s *DaemonService) GetPlotters() (*GetPlottersResponse, *http.Response, error) {
+	return s.GetPlottersWithContext(context.Background())
+}
+
+// GetPlottersWithContext is the same as GetPlotters, but the request is bound to ctx
+func (s *DaemonService) GetPlottersWithContext(ctx context.Context) (*GetPlottersResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_plotters", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPlottersResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
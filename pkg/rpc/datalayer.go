@@ -0,0 +1,478 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// DataLayerService encapsulates DataLayer RPC methods
+type DataLayerService struct {
+	client *Client
+}
+
+// NewRequest returns a new request specific to the DataLayer service
+func (s *DataLayerService) NewRequest(rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
+	return s.client.NewRequest(rpcinterface.ServiceDataLayer, rpcEndpoint, opt)
+}
+
+// Do is just a shortcut to the client's Do method
+func (s *DataLayerService) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.Do(req, v)
+}
+
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *DataLayerService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
+// CreateDataStoreOptions options for create_data_store
+type CreateDataStoreOptions struct {
+	Fee types.Mojo `json:"fee,omitempty"`
+}
+
+// CreateDataStoreResponse response from create_data_store
+type CreateDataStoreResponse struct {
+	rpcinterface.Response
+	TXs []*types.TransactionRecord `json:"txs"`
+	ID  string                     `json:"id"`
+}
+
+// CreateDataStore data_layer rpc -> create_data_store
+func (s *DataLayerService) CreateDataStore(opts *CreateDataStoreOptions) (*CreateDataStoreResponse, *http.Response, error) {
+	return s.CreateDataStoreWithContext(context.Background(), opts)
+}
+
+// CreateDataStoreWithContext is the same as CreateDataStore, but the request is bound to ctx
+func (s *DataLayerService) CreateDataStoreWithContext(ctx context.Context, opts *CreateDataStoreOptions) (*CreateDataStoreResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_data_store", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateDataStoreResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetOwnedStoresResponse response from get_owned_stores
+type GetOwnedStoresResponse struct {
+	rpcinterface.Response
+	StoreIDs []string `json:"store_ids"`
+}
+
+// GetOwnedStores data_layer rpc -> get_owned_stores
+func (s *DataLayerService) GetOwnedStores() (*GetOwnedStoresResponse, *http.Response, error) {
+	return s.GetOwnedStoresWithContext(context.Background())
+}
+
+// GetOwnedStoresWithContext is the same as GetOwnedStores, but the request is bound to ctx
+func (s *DataLayerService) GetOwnedStoresWithContext(ctx context.Context) (*GetOwnedStoresResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_owned_stores", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetOwnedStoresResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// BatchUpdateOptions options for batch_update
+type BatchUpdateOptions struct {
+	ID         string                   `json:"id"`
+	Changelist []*types.DataLayerChange `json:"changelist"`
+	Fee        types.Mojo               `json:"fee,omitempty"`
+}
+
+// BatchUpdateResponse response from batch_update
+type BatchUpdateResponse struct {
+	rpcinterface.Response
+	TXID string `json:"tx_id"`
+}
+
+// BatchUpdate data_layer rpc -> batch_update
+func (s *DataLayerService) BatchUpdate(opts *BatchUpdateOptions) (*BatchUpdateResponse, *http.Response, error) {
+	return s.BatchUpdateWithContext(context.Background(), opts)
+}
+
+// BatchUpdateWithContext is the same as BatchUpdate, but the request is bound to ctx
+func (s *DataLayerService) BatchUpdateWithContext(ctx context.Context, opts *BatchUpdateOptions) (*BatchUpdateResponse, *http.Response, error) {
+	request, err := s.NewRequest("batch_update", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &BatchUpdateResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetValueOptions options for get_value
+// RootHash is optional, and defaults to the latest root
+type GetValueOptions struct {
+	ID       string `json:"id"`
+	Key      string `json:"key"`
+	RootHash string `json:"root_hash,omitempty"`
+}
+
+// GetValueResponse response from get_value
+type GetValueResponse struct {
+	rpcinterface.Response
+	Value string `json:"value"`
+}
+
+// GetValue data_layer rpc -> get_value
+func (s *DataLayerService) GetValue(opts *GetValueOptions) (*GetValueResponse, *http.Response, error) {
+	return s.GetValueWithContext(context.Background(), opts)
+}
+
+// GetValueWithContext is the same as GetValue, but the request is bound to ctx
+func (s *DataLayerService) GetValueWithContext(ctx context.Context, opts *GetValueOptions) (*GetValueResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_value", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetValueResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DataStoreRootOptions options for calls that read a data store at a root
+// get_keys and get_keys_values. RootHash is optional, and defaults to the latest root
+type DataStoreRootOptions struct {
+	ID       string `json:"id"`
+	RootHash string `json:"root_hash,omitempty"`
+}
+
+// GetKeysResponse response from get_keys
+type GetKeysResponse struct {
+	rpcinterface.Response
+	Keys []string `json:"keys"`
+}
+
+// GetKeys data_layer rpc -> get_keys
+func (s *DataLayerService) GetKeys(opts *DataStoreRootOptions) (*GetKeysResponse, *http.Response, error) {
+	return s.GetKeysWithContext(context.Background(), opts)
+}
+
+// GetKeysWithContext is the same as GetKeys, but the request is bound to ctx
+func (s *DataLayerService) GetKeysWithContext(ctx context.Context, opts *DataStoreRootOptions) (*GetKeysResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_keys", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetKeysResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetKeysValuesResponse response from get_keys_values
+type GetKeysValuesResponse struct {
+	rpcinterface.Response
+	KeysValues []*types.DataLayerKeyValue `json:"keys_values"`
+}
+
+// GetKeysValues data_layer rpc -> get_keys_values
+func (s *DataLayerService) GetKeysValues(opts *DataStoreRootOptions) (*GetKeysValuesResponse, *http.Response, error) {
+	return s.GetKeysValuesWithContext(context.Background(), opts)
+}
+
+// GetKeysValuesWithContext is the same as GetKeysValues, but the request is bound to ctx
+func (s *DataLayerService) GetKeysValuesWithContext(ctx context.Context, opts *DataStoreRootOptions) (*GetKeysValuesResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_keys_values", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetKeysValuesResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DataStoreOptions options for calls that only need the data store ID
+// get_root, get_root_history, unsubscribe and get_mirrors
+type DataStoreOptions struct {
+	ID string `json:"id"`
+}
+
+// GetRootResponse response from get_root
+type GetRootResponse struct {
+	rpcinterface.Response
+	Hash      string `json:"hash"`
+	Confirmed bool   `json:"confirmed"`
+	Timestamp uint64 `json:"timestamp"` // @TODO time.Time?
+}
+
+// GetRoot data_layer rpc -> get_root
+func (s *DataLayerService) GetRoot(opts *DataStoreOptions) (*GetRootResponse, *http.Response, error) {
+	return s.GetRootWithContext(context.Background(), opts)
+}
+
+// GetRootWithContext is the same as GetRoot, but the request is bound to ctx
+func (s *DataLayerService) GetRootWithContext(ctx context.Context, opts *DataStoreOptions) (*GetRootResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_root", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetRootResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetRootsOptions options for get_roots
+type GetRootsOptions struct {
+	IDs []string `json:"ids"`
+}
+
+// GetRootsResponse response from get_roots
+type GetRootsResponse struct {
+	rpcinterface.Response
+	RootHashes []*types.DataLayerRoot `json:"root_hashes"`
+}
+
+// GetRoots data_layer rpc -> get_roots
+func (s *DataLayerService) GetRoots(opts *GetRootsOptions) (*GetRootsResponse, *http.Response, error) {
+	return s.GetRootsWithContext(context.Background(), opts)
+}
+
+// GetRootsWithContext is the same as GetRoots, but the request is bound to ctx
+func (s *DataLayerService) GetRootsWithContext(ctx context.Context, opts *GetRootsOptions) (*GetRootsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_roots", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetRootsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetRootHistoryResponse response from get_root_history
+type GetRootHistoryResponse struct {
+	rpcinterface.Response
+	RootHistory []*types.DataLayerRootHistory `json:"root_history"`
+}
+
+// GetRootHistory data_layer rpc -> get_root_history
+func (s *DataLayerService) GetRootHistory(opts *DataStoreOptions) (*GetRootHistoryResponse, *http.Response, error) {
+	return s.GetRootHistoryWithContext(context.Background(), opts)
+}
+
+// GetRootHistoryWithContext is the same as GetRootHistory, but the request is bound to ctx
+func (s *DataLayerService) GetRootHistoryWithContext(ctx context.Context, opts *DataStoreOptions) (*GetRootHistoryResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_root_history", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetRootHistoryResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetKVDiffOptions options for get_kv_diff
+type GetKVDiffOptions struct {
+	ID    string `json:"id"`
+	Hash1 string `json:"hash_1"`
+	Hash2 string `json:"hash_2"`
+}
+
+// GetKVDiffResponse response from get_kv_diff
+type GetKVDiffResponse struct {
+	rpcinterface.Response
+	Diff []*types.DataLayerDiff `json:"diff"`
+}
+
+// GetKVDiff data_layer rpc -> get_kv_diff returns the keys that changed between two roots
+func (s *DataLayerService) GetKVDiff(opts *GetKVDiffOptions) (*GetKVDiffResponse, *http.Response, error) {
+	return s.GetKVDiffWithContext(context.Background(), opts)
+}
+
+// GetKVDiffWithContext is the same as GetKVDiff, but the request is bound to ctx
+func (s *DataLayerService) GetKVDiffWithContext(ctx context.Context, opts *GetKVDiffOptions) (*GetKVDiffResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_kv_diff", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetKVDiffResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// SubscribeOptions options for subscribe
+type SubscribeOptions struct {
+	ID   string   `json:"id"`
+	URLs []string `json:"urls"`
+}
+
+// Subscribe data_layer rpc -> subscribe
+func (s *DataLayerService) Subscribe(opts *SubscribeOptions) (*EmptyResponse, *http.Response, error) {
+	return s.SubscribeWithContext(context.Background(), opts)
+}
+
+// SubscribeWithContext is the same as Subscribe, but the request is bound to ctx
+func (s *DataLayerService) SubscribeWithContext(ctx context.Context, opts *SubscribeOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("subscribe", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// Unsubscribe data_layer rpc -> unsubscribe
+func (s *DataLayerService) Unsubscribe(opts *DataStoreOptions) (*EmptyResponse, *http.Response, error) {
+	return s.UnsubscribeWithContext(context.Background(), opts)
+}
+
+// UnsubscribeWithContext is the same as Unsubscribe, but the request is bound to ctx
+func (s *DataLayerService) UnsubscribeWithContext(ctx context.Context, opts *DataStoreOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("unsubscribe", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetMirrorsResponse response from get_mirrors
+type GetMirrorsResponse struct {
+	rpcinterface.Response
+	Mirrors []*types.DataLayerMirror `json:"mirrors"`
+}
+
+// GetMirrors data_layer rpc -> get_mirrors
+func (s *DataLayerService) GetMirrors(opts *DataStoreOptions) (*GetMirrorsResponse, *http.Response, error) {
+	return s.GetMirrorsWithContext(context.Background(), opts)
+}
+
+// GetMirrorsWithContext is the same as GetMirrors, but the request is bound to ctx
+func (s *DataLayerService) GetMirrorsWithContext(ctx context.Context, opts *DataStoreOptions) (*GetMirrorsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_mirrors", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetMirrorsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// AddMirrorOptions options for add_mirror
+type AddMirrorOptions struct {
+	ID     string     `json:"id"`
+	URLs   []string   `json:"urls"`
+	Amount types.Mojo `json:"amount"`
+	Fee    types.Mojo `json:"fee,omitempty"`
+}
+
+// AddMirror data_layer rpc -> add_mirror
+func (s *DataLayerService) AddMirror(opts *AddMirrorOptions) (*EmptyResponse, *http.Response, error) {
+	return s.AddMirrorWithContext(context.Background(), opts)
+}
+
+// AddMirrorWithContext is the same as AddMirror, but the request is bound to ctx
+func (s *DataLayerService) AddMirrorWithContext(ctx context.Context, opts *AddMirrorOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("add_mirror", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteMirrorOptions options for delete_mirror
+type DeleteMirrorOptions struct {
+	CoinID string     `json:"coin_id"`
+	Fee    types.Mojo `json:"fee,omitempty"`
+}
+
+// DeleteMirror data_layer rpc -> delete_mirror
+func (s *DataLayerService) DeleteMirror(opts *DeleteMirrorOptions) (*EmptyResponse, *http.Response, error) {
+	return s.DeleteMirrorWithContext(context.Background(), opts)
+}
+
+// DeleteMirrorWithContext is the same as DeleteMirror, but the request is bound to ctx
+func (s *DataLayerService) DeleteMirrorWithContext(ctx context.Context, opts *DeleteMirrorOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("delete_mirror", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
@@ -0,0 +1,197 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// Origins used by chia services when they send websocket events
+const (
+	OriginFullNode = "chia_full_node"
+	OriginWallet   = "chia_wallet"
+	OriginTimelord = "chia_timelord"
+)
+
+// eventKey identifies a type of websocket event by the service that sent it and the command
+type eventKey struct {
+	origin  string
+	command string
+}
+
+// eventTypes maps each known event to a function that returns a new value of the type its data decodes into
+var eventTypes = map[eventKey]func() interface{}{
+	{OriginFullNode, "block"}:                func() interface{} { return &types.BlockEvent{} },
+	{OriginFullNode, "get_blockchain_state"}: func() interface{} { return &types.WebsocketBlockchainState{} },
+	{OriginFullNode, "signage_point"}:        func() interface{} { return &types.SignagePointEvent{} },
+	{OriginWallet, "coin_added"}:             func() interface{} { return &types.CoinAddedEvent{} },
+	{OriginTimelord, "finished_pot"}:         func() interface{} { return &types.FinishedPoTEvent{} },
+	{OriginTimelord, "new_compact_proof"}:    func() interface{} { return &types.NewCompactProofEvent{} },
+	{OriginTimelord, "skipping_peak"}:        func() interface{} { return &types.SkippingPeakEvent{} },
+	{OriginTimelord, "new_peak"}:             func() interface{} { return &types.NewPeakEvent{} },
+}
+
+// decodeEvent decodes the data of a websocket event into its typed struct
+// Returns nil, nil if the event is not one of the known event types
+func decodeEvent(resp *types.WebsocketResponse) (interface{}, error) {
+	newEvent, ok := eventTypes[eventKey{origin: resp.Origin, command: resp.Command}]
+	if !ok {
+		return nil, nil
+	}
+
+	event := newEvent()
+	err := json.Unmarshal(resp.Data, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// eventDispatcher routes websocket events to the typed handlers registered for them
+type eventDispatcher struct {
+	handlers        map[eventKey][]func(interface{})
+	unknownHandlers []rpcinterface.WebsocketResponseHandler
+	running         bool
+	lock            sync.RWMutex
+}
+
+// dispatch calls every typed handler registered for the event
+// Events without a typed handler, events that failed to decode, and errors are passed to the unknown event handlers
+func (d *eventDispatcher) dispatch(event *Event) {
+	d.lock.RLock()
+	handlers := d.handlers[eventKey{origin: event.Origin, command: event.Command}]
+	unknownHandlers := d.unknownHandlers
+	d.lock.RUnlock()
+
+	if event.Err == nil && event.Data != nil && len(handlers) > 0 {
+		for _, handler := range handlers {
+			handler(event.Data)
+		}
+		return
+	}
+
+	for _, handler := range unknownHandlers {
+		handler(event.Raw, event.Err)
+	}
+}
+
+// addEventHandler registers a typed handler for an event, and starts dispatching events if needed
+func (c *Client) addEventHandler(origin string, command string, handler func(interface{})) error {
+	c.events.lock.Lock()
+	if c.events.handlers == nil {
+		c.events.handlers = map[eventKey][]func(interface{}){}
+	}
+	key := eventKey{origin: origin, command: command}
+	c.events.handlers[key] = append(c.events.handlers[key], handler)
+	c.events.lock.Unlock()
+
+	return c.startEventDispatch()
+}
+
+// startEventDispatch starts a goroutine that dispatches events from a stream, if one isn't already running
+// The stream drops the oldest events when it falls behind, rather than blocking, so a handler that makes a request
+// over the same connection can't stall reading the response it is waiting for. Drops are reported to the unknown
+// event handlers as a *DroppedEventsError
+func (c *Client) startEventDispatch() error {
+	c.events.lock.Lock()
+	defer c.events.lock.Unlock()
+
+	if c.events.running {
+		return nil
+	}
+
+	stream, err := c.Events(context.Background(), EventFilter{}, WithOverflowPolicy(OverflowDropOldest))
+	if err != nil {
+		return err
+	}
+	c.events.running = true
+
+	c.handlers.Add(1)
+	go func() {
+		defer c.handlers.Done()
+		var reported uint64
+		for event := range stream.C {
+			if err := stream.droppedSince(&reported); err != nil {
+				c.events.dispatch(&Event{Err: err})
+			}
+			c.events.dispatch(event)
+		}
+
+		c.events.lock.Lock()
+		c.events.running = false
+		c.events.lock.Unlock()
+	}()
+
+	return nil
+}
+
+// OnBlock registers a handler that is called with every block event from the full node
+func (c *Client) OnBlock(handler func(*types.BlockEvent)) error {
+	return c.addEventHandler(OriginFullNode, "block", func(event interface{}) {
+		handler(event.(*types.BlockEvent))
+	})
+}
+
+// OnBlockchainState registers a handler that is called with every blockchain state event from the full node
+func (c *Client) OnBlockchainState(handler func(*types.WebsocketBlockchainState)) error {
+	return c.addEventHandler(OriginFullNode, "get_blockchain_state", func(event interface{}) {
+		handler(event.(*types.WebsocketBlockchainState))
+	})
+}
+
+// OnSignagePoint registers a handler that is called with every signage point event from the full node
+func (c *Client) OnSignagePoint(handler func(*types.SignagePointEvent)) error {
+	return c.addEventHandler(OriginFullNode, "signage_point", func(event interface{}) {
+		handler(event.(*types.SignagePointEvent))
+	})
+}
+
+// OnCoinAdded registers a handler that is called with every coin added event from the wallet
+func (c *Client) OnCoinAdded(handler func(*types.CoinAddedEvent)) error {
+	return c.addEventHandler(OriginWallet, "coin_added", func(event interface{}) {
+		handler(event.(*types.CoinAddedEvent))
+	})
+}
+
+// OnFinishedPoT registers a handler that is called with every finished PoT event from the timelord
+func (c *Client) OnFinishedPoT(handler func(*types.FinishedPoTEvent)) error {
+	return c.addEventHandler(OriginTimelord, "finished_pot", func(event interface{}) {
+		handler(event.(*types.FinishedPoTEvent))
+	})
+}
+
+// OnNewCompactProof registers a handler that is called with every new compact proof event from the timelord
+func (c *Client) OnNewCompactProof(handler func(*types.NewCompactProofEvent)) error {
+	return c.addEventHandler(OriginTimelord, "new_compact_proof", func(event interface{}) {
+		handler(event.(*types.NewCompactProofEvent))
+	})
+}
+
+// OnSkippingPeak registers a handler that is called with every skipping peak event from the timelord
+func (c *Client) OnSkippingPeak(handler func(*types.SkippingPeakEvent)) error {
+	return c.addEventHandler(OriginTimelord, "skipping_peak", func(event interface{}) {
+		handler(event.(*types.SkippingPeakEvent))
+	})
+}
+
+// OnNewPeak registers a handler that is called with every new peak event from the timelord
+func (c *Client) OnNewPeak(handler func(*types.NewPeakEvent)) error {
+	return c.addEventHandler(OriginTimelord, "new_peak", func(event interface{}) {
+		handler(event.(*types.NewPeakEvent))
+	})
+}
+
+// OnUnknownEvent registers a fallback handler for events that no typed handler is registered for
+// The fallback also receives events that failed to decode into their type, along with the decode error
+// and a *DroppedEventsError with a nil response when typed handlers fell behind and events were dropped
+func (c *Client) OnUnknownEvent(handler rpcinterface.WebsocketResponseHandler) error {
+	c.events.lock.Lock()
+	c.events.unknownHandlers = append(c.events.unknownHandlers, handler)
+	c.events.lock.Unlock()
+
+	return c.startEventDispatch()
+}
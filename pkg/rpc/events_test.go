@@ -0,0 +1,215 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// fakeListener is a client that hands events published by the test to ListenSync's handler
+// Methods other than ListenSync and Close are not implemented
+type fakeListener struct {
+	rpcinterface.Client
+
+	handlers  chan rpcinterface.WebsocketResponseHandler
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{
+		handlers: make(chan rpcinterface.WebsocketResponseHandler, 1),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (l *fakeListener) ListenSync(handler rpcinterface.WebsocketResponseHandler) error {
+	l.handlers <- handler
+	<-l.closed
+	return nil
+}
+
+func (l *fakeListener) Close(ctx context.Context) error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func TestEventDispatch(t *testing.T) {
+	d := &eventDispatcher{handlers: map[eventKey][]func(interface{}){}}
+
+	var blocks []*types.BlockEvent
+	d.handlers[eventKey{OriginFullNode, "block"}] = []func(interface{}){
+		func(event interface{}) { blocks = append(blocks, event.(*types.BlockEvent)) },
+	}
+
+	var unknown []string
+	var decodeErrors int
+	d.unknownHandlers = append(d.unknownHandlers, func(resp *types.WebsocketResponse, err error) {
+		if err != nil {
+			decodeErrors++
+			return
+		}
+		unknown = append(unknown, resp.Command)
+	})
+
+	d.dispatch(newEvent(&types.WebsocketResponse{Origin: OriginFullNode, Command: "block", Data: json.RawMessage(`{"height": 42, "header_hash": "0xabc"}`)}, nil))
+	d.dispatch(newEvent(&types.WebsocketResponse{Origin: OriginWallet, Command: "block", Data: json.RawMessage(`{"height": 1}`)}, nil))
+	d.dispatch(newEvent(&types.WebsocketResponse{Origin: OriginFullNode, Command: "some_new_event", Data: json.RawMessage(`{}`)}, nil))
+	d.dispatch(newEvent(&types.WebsocketResponse{Origin: OriginFullNode, Command: "block", Data: json.RawMessage(`{"height": "nope"}`)}, nil))
+
+	if len(blocks) != 1 || blocks[0].Height != 42 || blocks[0].HeaderHash != "0xabc" {
+		t.Fatalf("unexpected block events: %+v", blocks)
+	}
+	if len(unknown) != 2 || unknown[0] != "block" || unknown[1] != "some_new_event" {
+		t.Fatalf("unexpected unknown events: %v", unknown)
+	}
+	if decodeErrors != 1 {
+		t.Fatalf("expected 1 decode error, got %d", decodeErrors)
+	}
+}
+
+func TestHandlersDoNotBlockListening(t *testing.T) {
+	listener := newFakeListener()
+	c := &Client{activeClient: listener}
+
+	// Both handlers wait, as if they were making a request whose response hasn't been read yet
+	release := make(chan struct{})
+	var handled, dropped uint64
+	err := c.AddHandler(func(resp *types.WebsocketResponse, err error) {
+		var droppedErr *DroppedEventsError
+		if errors.As(err, &droppedErr) {
+			dropped += droppedErr.Count
+			return
+		}
+		<-release
+		handled++
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks, blocksDropped uint64
+	err = c.OnBlock(func(block *types.BlockEvent) {
+		<-release
+		blocks++
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.OnUnknownEvent(func(resp *types.WebsocketResponse, err error) {
+		var droppedErr *DroppedEventsError
+		if resp == nil && errors.As(err, &droppedErr) {
+			blocksDropped += droppedErr.Count
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish := <-listener.handlers
+
+	const count = 3 * defaultEventBufferSize
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < count; i++ {
+			publish(&types.WebsocketResponse{Origin: OriginFullNode, Command: "block", Data: json.RawMessage(`{"height": 1}`)}, nil)
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publishing events blocked on handlers that were waiting")
+	}
+
+	close(release)
+	if err = c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Close waits for the handlers, so the counts are final
+	if dropped == 0 || handled+dropped != count {
+		t.Errorf("expected every event to be handled or reported dropped, got %d handled and %d dropped", handled, dropped)
+	}
+	if blocksDropped == 0 || blocks+blocksDropped != count {
+		t.Errorf("expected every block to be handled or reported dropped, got %d handled and %d dropped", blocks, blocksDropped)
+	}
+}
+
+func TestEventsWhileDeliveryBlocked(t *testing.T) {
+	listener := newFakeListener()
+	c := &Client{activeClient: listener}
+
+	// Nothing reads this stream, so delivering to it blocks
+	full, err := c.Events(context.Background(), EventFilter{}, WithBufferSize(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish := <-listener.handlers
+	go publish(&types.WebsocketResponse{Origin: OriginFullNode, Command: "block", Data: json.RawMessage(`{"height": 1}`)}, nil)
+	time.Sleep(50 * time.Millisecond)
+
+	// A consumer adding a stream, such as a handler calling AddHandler, must not wait on the blocked delivery
+	added := make(chan error, 1)
+	go func() {
+		_, err := c.Events(context.Background(), EventFilter{})
+		added <- err
+	}()
+	select {
+	case err = <-added:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("adding a stream blocked on a delivery waiting for room")
+	}
+
+	<-full.C
+	if err = c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCloseWaitsForHandlers(t *testing.T) {
+	listener := newFakeListener()
+	c := &Client{activeClient: listener}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var returned int32
+	err := c.OnBlock(func(block *types.BlockEvent) {
+		close(started)
+		<-release
+		atomic.StoreInt32(&returned, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish := <-listener.handlers
+	publish(&types.WebsocketResponse{Origin: OriginFullNode, Command: "block", Data: json.RawMessage(`{"height": 1}`)}, nil)
+	<-started
+
+	// Close gives up when the context is done while the handler is still running
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err = c.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected Close to time out waiting for the handler, got %v", err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+	if err = c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&returned) != 1 {
+		t.Error("expected Close to wait for the handler to return")
+	}
+}
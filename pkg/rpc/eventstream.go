@@ -0,0 +1,346 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// defaultEventBufferSize is the number of events a stream buffers when no buffer size is provided
+const defaultEventBufferSize = 100
+
+// Event is a single event received over the websocket
+type Event struct {
+	Origin  string
+	Command string
+
+	// Data is the event decoded into its type, such as *types.BlockEvent
+	// nil for events that don't have a known type, or that failed to decode
+	Data interface{}
+
+	// Raw is the event exactly as it was received
+	Raw *types.WebsocketResponse
+
+	// Err is set if the event could not be read or decoded
+	Err error
+}
+
+// EventFilter limits the events delivered to a stream
+// Empty fields match everything
+type EventFilter struct {
+	Origins  []string
+	Commands []string
+}
+
+// matches returns true if the event should be delivered to a stream using this filter
+func (f EventFilter) matches(event *Event) bool {
+	return matchesAny(f.Origins, event.Origin) && matchesAny(f.Commands, event.Command)
+}
+
+func matchesAny(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OverflowPolicy determines what happens when an event arrives and a stream's buffer is full
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock waits for the consumer to make room in the buffer
+	// This holds up delivery to every other stream, and reading from the connection, until there is room
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropOldest discards the oldest buffered event to make room for the new one
+	OverflowDropOldest
+
+	// OverflowDropNewest discards the new event
+	OverflowDropNewest
+)
+
+// EventStreamOptionFunc can be used to customize a new EventStream
+type EventStreamOptionFunc func(stream *EventStream) error
+
+// WithBufferSize sets how many events the stream buffers before the overflow policy applies
+func WithBufferSize(size int) EventStreamOptionFunc {
+	return func(stream *EventStream) error {
+		if size < 0 {
+			return fmt.Errorf("buffer size must not be negative")
+		}
+		stream.bufferSize = size
+		return nil
+	}
+}
+
+// WithOverflowPolicy sets what happens when an event arrives and the stream's buffer is full
+func WithOverflowPolicy(policy OverflowPolicy) EventStreamOptionFunc {
+	return func(stream *EventStream) error {
+		stream.policy = policy
+		return nil
+	}
+}
+
+// EventStream delivers the events matching a filter to a single consumer
+type EventStream struct {
+	// dropped is first so it is 64-bit aligned for atomic operations on 32-bit platforms
+	dropped uint64
+
+	// C receives the events. It is closed when the stream ends
+	C <-chan *Event
+
+	events     chan *Event
+	filter     EventFilter
+	bufferSize int
+	policy     OverflowPolicy
+
+	// sendLock is held while delivering an event, so the events channel is never closed mid send
+	sendLock  sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+
+	err     error
+	errLock sync.Mutex
+}
+
+// Dropped returns the number of events discarded because the buffer was full
+func (s *EventStream) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// DroppedEventsError is passed to handlers when events were discarded because the handler fell a full buffer of
+// events behind
+type DroppedEventsError struct {
+	// Count is the number of events dropped since the handler was last called
+	Count uint64
+}
+
+func (e *DroppedEventsError) Error() string {
+	return fmt.Sprintf("%d events were dropped because the handler fell behind", e.Count)
+}
+
+// droppedSince returns a DroppedEventsError for the events dropped since the count in reported, and updates reported
+// Returns nil if no more events have been dropped
+func (s *EventStream) droppedSince(reported *uint64) error {
+	dropped := s.Dropped()
+	if dropped == *reported {
+		return nil
+	}
+	err := &DroppedEventsError{Count: dropped - *reported}
+	*reported = dropped
+
+	return err
+}
+
+// Err returns the reason the stream ended, once C is closed
+// This is the context's error if the context was done, or the error that stopped listening on the connection
+func (s *EventStream) Err() error {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+
+	return s.err
+}
+
+// deliver sends the event to the stream according to the stream's overflow policy
+// A delivery blocked waiting for room in the buffer is abandoned when stop is closed
+func (s *EventStream) deliver(event *Event, stop <-chan struct{}) {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	switch s.policy {
+	case OverflowDropOldest:
+		select {
+		case s.events <- event:
+			return
+		default:
+		}
+		select {
+		case <-s.events:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+		select {
+		case s.events <- event:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	case OverflowDropNewest:
+		select {
+		case s.events <- event:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	default:
+		select {
+		case s.events <- event:
+		case <-s.done:
+		case <-stop:
+		}
+	}
+}
+
+// close ends the stream with the provided reason
+func (s *EventStream) close(err error) {
+	s.closeOnce.Do(func() {
+		s.errLock.Lock()
+		s.err = err
+		s.errLock.Unlock()
+
+		// Closing done first releases any delivery that is blocked waiting for room in the buffer
+		close(s.done)
+
+		s.sendLock.Lock()
+		close(s.events)
+		s.sendLock.Unlock()
+	})
+}
+
+// newEvent builds an Event from a message received over the websocket, decoding its data if the type is known
+func newEvent(resp *types.WebsocketResponse, err error) *Event {
+	event := &Event{
+		Raw: resp,
+		Err: err,
+	}
+	if resp != nil {
+		event.Origin = resp.Origin
+		event.Command = resp.Command
+		if err == nil {
+			event.Data, event.Err = decodeEvent(resp)
+		}
+	}
+
+	return event
+}
+
+// eventBroker fans events received over the connection out to every stream
+type eventBroker struct {
+	streams   map[*EventStream]struct{}
+	listening bool
+	lock      sync.RWMutex
+
+	// stop is closed when listening stops, and stopped once every stream has been ended
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// publish is the handler for the connection, and delivers each event to every stream with a matching filter
+func (b *eventBroker) publish(resp *types.WebsocketResponse, err error) {
+	event := newEvent(resp, err)
+
+	// Deliver without holding the lock, since a delivery may block until a consumer makes room, and consumers may
+	// add or remove streams
+	b.lock.RLock()
+	var streams []*EventStream
+	for stream := range b.streams {
+		if stream.filter.matches(event) {
+			streams = append(streams, stream)
+		}
+	}
+	stop := b.stop
+	b.lock.RUnlock()
+
+	for _, stream := range streams {
+		stream.deliver(event, stop)
+	}
+}
+
+// newEventStream returns a new stream with the provided options applied
+func newEventStream(filter EventFilter, options ...EventStreamOptionFunc) (*EventStream, error) {
+	stream := &EventStream{
+		filter:     filter,
+		bufferSize: defaultEventBufferSize,
+		policy:     OverflowBlock,
+		done:       make(chan struct{}),
+	}
+	for _, fn := range options {
+		if fn == nil {
+			continue
+		}
+		if err := fn(stream); err != nil {
+			return nil, err
+		}
+	}
+	stream.events = make(chan *Event, stream.bufferSize)
+	stream.C = stream.events
+
+	return stream, nil
+}
+
+// Events returns a stream of the events matching filter that are received over the websocket
+// Each call returns an independent stream, so multiple consumers can watch the same connection
+// The stream ends, and its channel is closed, when ctx is done or listening on the connection stops
+// Events are only sent by chia for services that have been subscribed to with Subscribe
+func (c *Client) Events(ctx context.Context, filter EventFilter, options ...EventStreamOptionFunc) (*EventStream, error) {
+	stream, err := newEventStream(filter, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.broker.lock.Lock()
+	if c.broker.streams == nil {
+		c.broker.streams = map[*EventStream]struct{}{}
+	}
+	c.broker.streams[stream] = struct{}{}
+	if !c.broker.listening {
+		c.broker.listening = true
+		c.broker.stop = make(chan struct{})
+		c.broker.stopped = make(chan struct{})
+		go c.listen(c.broker.stop, c.broker.stopped)
+	}
+	c.broker.lock.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.removeStream(stream, ctx.Err())
+		case <-stream.done:
+		}
+	}()
+
+	return stream, nil
+}
+
+// removeStream ends the stream and stops delivering events to it
+func (c *Client) removeStream(stream *EventStream, err error) {
+	// Closing first releases any delivery to this stream that is blocked waiting for room in the buffer
+	stream.close(err)
+
+	c.broker.lock.Lock()
+	delete(c.broker.streams, stream)
+	c.broker.lock.Unlock()
+}
+
+// listen passes everything received over the connection to the broker until listening stops,
+// then ends every stream with the error that stopped it
+func (c *Client) listen(stop chan struct{}, stopped chan struct{}) {
+	err := c.activeClient.ListenSync(c.broker.publish)
+
+	// Release any delivery blocked on a full stream
+	close(stop)
+
+	c.broker.lock.Lock()
+	streams := c.broker.streams
+	c.broker.streams = nil
+	c.broker.listening = false
+	c.broker.lock.Unlock()
+
+	for stream := range streams {
+		stream.close(err)
+	}
+	close(stopped)
+}
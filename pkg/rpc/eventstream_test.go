@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestEventStreamOverflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      OverflowPolicy
+		wantHeights []uint32
+		wantDropped uint64
+	}{
+		{name: "drop oldest", policy: OverflowDropOldest, wantHeights: []uint32{3, 4}, wantDropped: 2},
+		{name: "drop newest", policy: OverflowDropNewest, wantHeights: []uint32{1, 2}, wantDropped: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := newEventStream(EventFilter{}, WithBufferSize(2), WithOverflowPolicy(tt.policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for height := uint32(1); height <= 4; height++ {
+				stream.deliver(&Event{Command: "block", Data: &types.BlockEvent{Height: height}}, nil)
+			}
+			stream.close(nil)
+
+			var heights []uint32
+			for event := range stream.C {
+				heights = append(heights, event.Data.(*types.BlockEvent).Height)
+			}
+			if len(heights) != len(tt.wantHeights) || heights[0] != tt.wantHeights[0] || heights[1] != tt.wantHeights[1] {
+				t.Fatalf("expected heights %v, got %v", tt.wantHeights, heights)
+			}
+			if stream.Dropped() != tt.wantDropped {
+				t.Fatalf("expected %d dropped, got %d", tt.wantDropped, stream.Dropped())
+			}
+		})
+	}
+}
+
+func TestEventStreamBlockReleasedOnClose(t *testing.T) {
+	stream, err := newEventStream(EventFilter{}, WithBufferSize(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delivered := make(chan struct{})
+	go func() {
+		stream.deliver(&Event{Command: "block"}, nil)
+		close(delivered)
+	}()
+
+	stream.close(context.Canceled)
+	<-delivered
+
+	if _, ok := <-stream.C; ok {
+		t.Fatal("expected the stream to be closed")
+	}
+	if !errors.Is(stream.Err(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", stream.Err())
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	filter := EventFilter{Origins: []string{OriginFullNode}, Commands: []string{"block", "signage_point"}}
+
+	if !filter.matches(&Event{Origin: OriginFullNode, Command: "block"}) {
+		t.Error("expected full node block to match")
+	}
+	if filter.matches(&Event{Origin: OriginWallet, Command: "block"}) {
+		t.Error("expected wallet block not to match")
+	}
+	if filter.matches(&Event{Origin: OriginFullNode, Command: "get_blockchain_state"}) {
+		t.Error("expected blockchain state not to match")
+	}
+	if !(EventFilter{}).matches(&Event{Origin: OriginWallet, Command: "coin_added"}) {
+		t.Error("expected empty filter to match everything")
+	}
+}
@@ -0,0 +1,434 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// FarmerService encapsulates farmer RPC methods
+type FarmerService struct {
+	client *Client
+}
+
+// NewRequest returns a new request specific to the farmer service
+func (s *FarmerService) NewRequest(rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
+	return s.client.NewRequest(rpcinterface.ServiceFarmer, rpcEndpoint, opt)
+}
+
+// Do is just a shortcut to the client's Do method
+func (s *FarmerService) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.Do(req, v)
+}
+
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *FarmerService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
+// GetFarmerSignagePointsResponse response for get_signage_points on farmer
+type GetFarmerSignagePointsResponse struct {
+	rpcinterface.Response
+	SignagePoints []*types.FarmerSignagePoint `json:"signage_points"`
+}
+
+// GetSignagePoints farmer rpc -> get_signage_points returns recent signage points and the proofs found for them
+func (s *FarmerService) GetSignagePoints() (*GetFarmerSignagePointsResponse, *http.Response, error) {
+	return s.GetSignagePointsWithContext(context.Background())
+}
+
+// GetSignagePointsWithContext is the same as GetSignagePoints, but the request is bound to ctx
+func (s *FarmerService) GetSignagePointsWithContext(ctx context.Context) (*GetFarmerSignagePointsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_signage_points", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetFarmerSignagePointsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetFarmerSignagePointOptions options for get_signage_point on farmer
+type GetFarmerSignagePointOptions struct {
+	SPHash string `json:"sp_hash"`
+}
+
+// GetFarmerSignagePointResponse response for get_signage_point on farmer
+type GetFarmerSignagePointResponse struct {
+	rpcinterface.Response
+	types.FarmerSignagePoint
+}
+
+// GetSignagePoint farmer rpc -> get_signage_point returns a single signage point by its challenge chain sp hash
+func (s *FarmerService) GetSignagePoint(opts *GetFarmerSignagePointOptions) (*GetFarmerSignagePointResponse, *http.Response, error) {
+	return s.GetSignagePointWithContext(context.Background(), opts)
+}
+
+// GetSignagePointWithContext is the same as GetSignagePoint, but the request is bound to ctx
+func (s *FarmerService) GetSignagePointWithContext(ctx context.Context, opts *GetFarmerSignagePointOptions) (*GetFarmerSignagePointResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_signage_point", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetFarmerSignagePointResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetRewardTargetsOptions options for get_reward_targets on farmer
+type GetRewardTargetsOptions struct {
+	SearchForPrivateKey bool   `json:"search_for_private_key"`
+	MaxPHToSearch       uint32 `json:"max_ph_to_search,omitempty"`
+}
+
+// GetRewardTargetsResponse response for get_reward_targets on farmer
+// HaveFarmerSK and HavePoolSK are only set when SearchForPrivateKey is true
+type GetRewardTargetsResponse struct {
+	rpcinterface.Response
+	FarmerTarget types.Address `json:"farmer_target"`
+	PoolTarget   types.Address `json:"pool_target"`
+	HaveFarmerSK bool          `json:"have_farmer_sk"`
+	HavePoolSK   bool          `json:"have_pool_sk"`
+}
+
+// GetRewardTargets farmer rpc -> get_reward_targets
+func (s *FarmerService) GetRewardTargets(opts *GetRewardTargetsOptions) (*GetRewardTargetsResponse, *http.Response, error) {
+	return s.GetRewardTargetsWithContext(context.Background(), opts)
+}
+
+// GetRewardTargetsWithContext is the same as GetRewardTargets, but the request is bound to ctx
+func (s *FarmerService) GetRewardTargetsWithContext(ctx context.Context, opts *GetRewardTargetsOptions) (*GetRewardTargetsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_reward_targets", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetRewardTargetsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// SetRewardTargetsOptions options for set_reward_targets on farmer
+// Either target may be left empty to leave it unchanged
+type SetRewardTargetsOptions struct {
+	FarmerTarget types.Address `json:"farmer_target,omitempty"`
+	PoolTarget   types.Address `json:"pool_target,omitempty"`
+}
+
+// SetRewardTargetsResponse response for set_reward_targets on farmer
+type SetRewardTargetsResponse struct {
+	rpcinterface.Response
+}
+
+// SetRewardTargets farmer rpc -> set_reward_targets
+func (s *FarmerService) SetRewardTargets(opts *SetRewardTargetsOptions) (*SetRewardTargetsResponse, *http.Response, error) {
+	return s.SetRewardTargetsWithContext(context.Background(), opts)
+}
+
+// SetRewardTargetsWithContext is the same as SetRewardTargets, but the request is bound to ctx
+func (s *FarmerService) SetRewardTargetsWithContext(ctx context.Context, opts *SetRewardTargetsOptions) (*SetRewardTargetsResponse, *http.Response, error) {
+	request, err := s.NewRequest("set_reward_targets", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SetRewardTargetsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPoolStateResponse response for get_pool_state on farmer
+type GetPoolStateResponse struct {
+	rpcinterface.Response
+	PoolState []*types.FarmerPoolState `json:"pool_state"`
+}
+
+// GetPoolState farmer rpc -> get_pool_state
+func (s *FarmerService) GetPoolState() (*GetPoolStateResponse, *http.Response, error) {
+	return s.GetPoolStateWithContext(context.Background())
+}
+
+// GetPoolStateWithContext is the same as GetPoolState, but the request is bound to ctx
+func (s *FarmerService) GetPoolStateWithContext(ctx context.Context) (*GetPoolStateResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_pool_state", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPoolStateResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// SetPayoutInstructionsOptions options for set_payout_instructions on farmer
+type SetPayoutInstructionsOptions struct {
+	LauncherID         string `json:"launcher_id"`
+	PayoutInstructions string `json:"payout_instructions"`
+}
+
+// SetPayoutInstructionsResponse response for set_payout_instructions on farmer
+type SetPayoutInstructionsResponse struct {
+	rpcinterface.Response
+}
+
+// SetPayoutInstructions farmer rpc -> set_payout_instructions
+func (s *FarmerService) SetPayoutInstructions(opts *SetPayoutInstructionsOptions) (*SetPayoutInstructionsResponse, *http.Response, error) {
+	return s.SetPayoutInstructionsWithContext(context.Background(), opts)
+}
+
+// SetPayoutInstructionsWithContext is the same as SetPayoutInstructions, but the request is bound to ctx
+func (s *FarmerService) SetPayoutInstructionsWithContext(ctx context.Context, opts *SetPayoutInstructionsOptions) (*SetPayoutInstructionsResponse, *http.Response, error) {
+	request, err := s.NewRequest("set_payout_instructions", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SetPayoutInstructionsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetHarvestersResponse response for get_harvesters on farmer
+type GetHarvestersResponse struct {
+	rpcinterface.Response
+	Harvesters []*types.HarvesterDetails `json:"harvesters"`
+}
+
+// GetHarvesters farmer rpc -> get_harvesters returns every connected harvester including all plots
+func (s *FarmerService) GetHarvesters() (*GetHarvestersResponse, *http.Response, error) {
+	return s.GetHarvestersWithContext(context.Background())
+}
+
+// GetHarvestersWithContext is the same as GetHarvesters, but the request is bound to ctx
+func (s *FarmerService) GetHarvestersWithContext(ctx context.Context) (*GetHarvestersResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvesters", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvestersResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetHarvestersSummaryResponse response for get_harvesters_summary on farmer
+type GetHarvestersSummaryResponse struct {
+	rpcinterface.Response
+	Harvesters []*types.HarvesterSummary `json:"harvesters"`
+}
+
+// GetHarvestersSummary farmer rpc -> get_harvesters_summary returns every connected harvester with plot counts instead of plots
+func (s *FarmerService) GetHarvestersSummary() (*GetHarvestersSummaryResponse, *http.Response, error) {
+	return s.GetHarvestersSummaryWithContext(context.Background())
+}
+
+// GetHarvestersSummaryWithContext is the same as GetHarvestersSummary, but the request is bound to ctx
+func (s *FarmerService) GetHarvestersSummaryWithContext(ctx context.Context) (*GetHarvestersSummaryResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvesters_summary", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvestersSummaryResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PlotInfoFilterItem filters plots where the field Key matches Value
+type PlotInfoFilterItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GetHarvesterPlotsValidOptions options for get_harvester_plots_valid on farmer
+// Filter and SortKey are left to chia's defaults, no filter and sorted by filename, when empty
+type GetHarvesterPlotsValidOptions struct {
+	NodeID   string                `json:"node_id"`
+	Page     uint32                `json:"page"`
+	PageSize uint32                `json:"page_size"`
+	Filter   []*PlotInfoFilterItem `json:"filter,omitempty"`
+	SortKey  string                `json:"sort_key,omitempty"`
+	Reverse  bool                  `json:"reverse"`
+}
+
+// GetHarvesterPlotsValidResponse response for get_harvester_plots_valid on farmer
+type GetHarvesterPlotsValidResponse struct {
+	rpcinterface.Response
+	NodeID     string            `json:"node_id"`
+	Page       uint32            `json:"page"`
+	PageCount  uint32            `json:"page_count"`
+	TotalCount uint32            `json:"total_count"`
+	Plots      []*types.PlotInfo `json:"plots"`
+}
+
+// GetHarvesterPlotsValid farmer rpc -> get_harvester_plots_valid returns a page of the valid plots on a harvester
+func (s *FarmerService) GetHarvesterPlotsValid(opts *GetHarvesterPlotsValidOptions) (*GetHarvesterPlotsValidResponse, *http.Response, error) {
+	return s.GetHarvesterPlotsValidWithContext(context.Background(), opts)
+}
+
+// GetHarvesterPlotsValidWithContext is the same as GetHarvesterPlotsValid, but the request is bound to ctx
+func (s *FarmerService) GetHarvesterPlotsValidWithContext(ctx context.Context, opts *GetHarvesterPlotsValidOptions) (*GetHarvesterPlotsValidResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvester_plots_valid", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvesterPlotsValidResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetHarvesterPlotPathsOptions options for the farmer rpc calls that return a page of plot paths
+// get_harvester_plots_invalid, get_harvester_plots_keys_missing and get_harvester_plots_duplicates
+type GetHarvesterPlotPathsOptions struct {
+	NodeID   string   `json:"node_id"`
+	Page     uint32   `json:"page"`
+	PageSize uint32   `json:"page_size"`
+	Filter   []string `json:"filter,omitempty"`
+	Reverse  bool     `json:"reverse"`
+}
+
+// GetHarvesterPlotPathsResponse response for the farmer rpc calls that return a page of plot paths
+type GetHarvesterPlotPathsResponse struct {
+	rpcinterface.Response
+	NodeID     string   `json:"node_id"`
+	Page       uint32   `json:"page"`
+	PageCount  uint32   `json:"page_count"`
+	TotalCount uint32   `json:"total_count"`
+	Plots      []string `json:"plots"`
+}
+
+// GetHarvesterPlotsInvalid farmer rpc -> get_harvester_plots_invalid returns a page of plots the harvester failed to open
+func (s *FarmerService) GetHarvesterPlotsInvalid(opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	return s.GetHarvesterPlotsInvalidWithContext(context.Background(), opts)
+}
+
+// GetHarvesterPlotsInvalidWithContext is the same as GetHarvesterPlotsInvalid, but the request is bound to ctx
+func (s *FarmerService) GetHarvesterPlotsInvalidWithContext(ctx context.Context, opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvester_plots_invalid", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvesterPlotPathsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetHarvesterPlotsKeysMissing farmer rpc -> get_harvester_plots_keys_missing returns a page of plots the harvester has no keys for
+func (s *FarmerService) GetHarvesterPlotsKeysMissing(opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	return s.GetHarvesterPlotsKeysMissingWithContext(context.Background(), opts)
+}
+
+// GetHarvesterPlotsKeysMissingWithContext is the same as GetHarvesterPlotsKeysMissing, but the request is bound to ctx
+func (s *FarmerService) GetHarvesterPlotsKeysMissingWithContext(ctx context.Context, opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvester_plots_keys_missing", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvesterPlotPathsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetHarvesterPlotsDuplicates farmer rpc -> get_harvester_plots_duplicates returns a page of duplicate plots on the harvester
+func (s *FarmerService) GetHarvesterPlotsDuplicates(opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	return s.GetHarvesterPlotsDuplicatesWithContext(context.Background(), opts)
+}
+
+// GetHarvesterPlotsDuplicatesWithContext is the same as GetHarvesterPlotsDuplicates, but the request is bound to ctx
+func (s *FarmerService) GetHarvesterPlotsDuplicatesWithContext(ctx context.Context, opts *GetHarvesterPlotPathsOptions) (*GetHarvesterPlotPathsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_harvester_plots_duplicates", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetHarvesterPlotPathsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPoolLoginLinkOptions options for get_pool_login_link on farmer
+type GetPoolLoginLinkOptions struct {
+	LauncherID string `json:"launcher_id"`
+}
+
+// GetPoolLoginLinkResponse response for get_pool_login_link on farmer
+type GetPoolLoginLinkResponse struct {
+	rpcinterface.Response
+	LoginLink string `json:"login_link"`
+}
+
+// GetPoolLoginLink farmer rpc -> get_pool_login_link
+func (s *FarmerService) GetPoolLoginLink(opts *GetPoolLoginLinkOptions) (*GetPoolLoginLinkResponse, *http.Response, error) {
+	return s.GetPoolLoginLinkWithContext(context.Background(), opts)
+}
+
+// GetPoolLoginLinkWithContext is the same as GetPoolLoginLink, but the request is bound to ctx
+func (s *FarmerService) GetPoolLoginLinkWithContext(ctx context.Context, opts *GetPoolLoginLinkOptions) (*GetPoolLoginLinkResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_pool_login_link", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPoolLoginLinkResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
 	"net/http"
 
@@ -22,6 +23,11 @@ func (s *FullNodeService) Do(req *rpcinterface.Request, v interface{}) (*http.Re
 	return s.client.Do(req, v)
 }
 
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *FullNodeService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
 // GetConnectionsOptions options to filter get_connections
 type GetConnectionsOptions struct {
 	NodeType types.NodeType `json:"node_type,omitempty"`
@@ -29,19 +35,24 @@ type GetConnectionsOptions struct {
 
 // GetConnectionsResponse get_connections response format
 type GetConnectionsResponse struct {
-	Success     bool                `json:"success"`
+	rpcinterface.Response
 	Connections []*types.Connection `json:"connections"`
 }
 
 // GetConnections returns connections
 func (s *FullNodeService) GetConnections(opts *GetConnectionsOptions) (*GetConnectionsResponse, *http.Response, error) {
+	return s.GetConnectionsWithContext(context.Background(), opts)
+}
+
+// GetConnectionsWithContext is the same as GetConnections, but the request is bound to ctx
+func (s *FullNodeService) GetConnectionsWithContext(ctx context.Context, opts *GetConnectionsOptions) (*GetConnectionsResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_connections", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	c := &GetConnectionsResponse{}
-	resp, err := s.Do(request, c)
+	resp, err := s.DoWithContext(ctx, request, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -51,19 +62,24 @@ func (s *FullNodeService) GetConnections(opts *GetConnectionsOptions) (*GetConne
 
 // GetBlockchainStateResponse is the blockchain state RPC response
 type GetBlockchainStateResponse struct {
-	Success         bool                   `json:"success"`
+	rpcinterface.Response
 	BlockchainState *types.BlockchainState `json:"blockchain_state"`
 }
 
 // GetBlockchainState returns blockchain state
 func (s *FullNodeService) GetBlockchainState() (*GetBlockchainStateResponse, *http.Response, error) {
+	return s.GetBlockchainStateWithContext(context.Background())
+}
+
+// GetBlockchainStateWithContext is the same as GetBlockchainState, but the request is bound to ctx
+func (s *FullNodeService) GetBlockchainStateWithContext(ctx context.Context) (*GetBlockchainStateResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_blockchain_state", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetBlockchainStateResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -78,19 +94,24 @@ type GetBlockOptions struct {
 
 // GetBlockResponse response for get_block rpc call
 type GetBlockResponse struct {
-	Success bool             `json:"success"`
-	Block   *types.FullBlock `json:"block"`
+	rpcinterface.Response
+	Block *types.FullBlock `json:"block"`
 }
 
 // GetBlock full_node->get_block RPC method
 func (s *FullNodeService) GetBlock(opts *GetBlockOptions) (*GetBlockResponse, *http.Response, error) {
+	return s.GetBlockWithContext(context.Background(), opts)
+}
+
+// GetBlockWithContext is the same as GetBlock, but the request is bound to ctx
+func (s *FullNodeService) GetBlockWithContext(ctx context.Context, opts *GetBlockOptions) (*GetBlockResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_block", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetBlockResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -102,23 +123,32 @@ func (s *FullNodeService) GetBlock(opts *GetBlockOptions) (*GetBlockResponse, *h
 type GetBlocksOptions struct {
 	Start int `json:"start"`
 	End   int `json:"end"`
+	// ExcludeHeaderHash skips adding header_hash to each block, which saves the node from hashing every block
+	ExcludeHeaderHash bool `json:"exclude_header_hash"`
+	// ExcludeReorged only returns blocks that are in the current chain
+	ExcludeReorged bool `json:"exclude_reorged"`
 }
 
 // GetBlocksResponse response for get_blocks rpc call
 type GetBlocksResponse struct {
-	Success bool               `json:"success"`
-	Blocks  []*types.FullBlock `json:"blocks"`
+	rpcinterface.Response
+	Blocks []*types.FullBlock `json:"blocks"`
 }
 
 // GetBlocks full_node->get_blocks RPC method
 func (s *FullNodeService) GetBlocks(opts *GetBlocksOptions) (*GetBlocksResponse, *http.Response, error) {
+	return s.GetBlocksWithContext(context.Background(), opts)
+}
+
+// GetBlocksWithContext is the same as GetBlocks, but the request is bound to ctx
+func (s *FullNodeService) GetBlocksWithContext(ctx context.Context, opts *GetBlocksOptions) (*GetBlocksResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_blocks", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetBlocksResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -128,12 +158,17 @@ func (s *FullNodeService) GetBlocks(opts *GetBlocksOptions) (*GetBlocksResponse,
 
 // GetBlockCountMetricsResponse response for get_block_count_metrics rpc call
 type GetBlockCountMetricsResponse struct {
-	Success bool                     `json:"success"`
+	rpcinterface.Response
 	Metrics *types.BlockCountMetrics `json:"metrics"`
 }
 
 // GetBlockCountMetrics gets metrics about blocks
 func (s *FullNodeService) GetBlockCountMetrics() (*GetBlockCountMetricsResponse, *http.Response, error) {
+	return s.GetBlockCountMetricsWithContext(context.Background())
+}
+
+// GetBlockCountMetricsWithContext is the same as GetBlockCountMetrics, but the request is bound to ctx
+func (s *FullNodeService) GetBlockCountMetricsWithContext(ctx context.Context) (*GetBlockCountMetricsResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_block_count_metrics", nil)
 	if err != nil {
 		return nil, nil, err
@@ -141,7 +176,7 @@ func (s *FullNodeService) GetBlockCountMetrics() (*GetBlockCountMetricsResponse,
 
 	r := &GetBlockCountMetricsResponse{}
 
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -156,12 +191,17 @@ type GetBlockByHeightOptions struct {
 
 // GetBlockRecordResponse response from get_block_record_by_height
 type GetBlockRecordResponse struct {
-	Success     bool               `json:"success"`
+	rpcinterface.Response
 	BlockRecord *types.BlockRecord `json:"block_record"`
 }
 
 // GetBlockRecordByHeight full_node->get_block_record_by_height RPC method
 func (s *FullNodeService) GetBlockRecordByHeight(opts *GetBlockByHeightOptions) (*GetBlockRecordResponse, *http.Response, error) {
+	return s.GetBlockRecordByHeightWithContext(context.Background(), opts)
+}
+
+// GetBlockRecordByHeightWithContext is the same as GetBlockRecordByHeight, but the request is bound to ctx
+func (s *FullNodeService) GetBlockRecordByHeightWithContext(ctx context.Context, opts *GetBlockByHeightOptions) (*GetBlockRecordResponse, *http.Response, error) {
 	// Get Block Record
 	request, err := s.NewRequest("get_block_record_by_height", opts)
 	if err != nil {
@@ -169,7 +209,7 @@ func (s *FullNodeService) GetBlockRecordByHeight(opts *GetBlockByHeightOptions)
 	}
 
 	record := &GetBlockRecordResponse{}
-	resp, err := s.Do(request, record)
+	resp, err := s.DoWithContext(ctx, request, record)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -185,8 +225,13 @@ func (s *FullNodeService) GetBlockRecordByHeight(opts *GetBlockByHeightOptions)
 
 // GetBlockByHeight helper function to get a full block by height, calls full_node->get_block_record_by_height RPC method then full_node->get_block RPC method
 func (s *FullNodeService) GetBlockByHeight(opts *GetBlockByHeightOptions) (*GetBlockResponse, *http.Response, error) {
+	return s.GetBlockByHeightWithContext(context.Background(), opts)
+}
+
+// GetBlockByHeightWithContext is the same as GetBlockByHeight, but the request is bound to ctx
+func (s *FullNodeService) GetBlockByHeightWithContext(ctx context.Context, opts *GetBlockByHeightOptions) (*GetBlockResponse, *http.Response, error) {
 	// Get Block Record
-	record, resp, err := s.GetBlockRecordByHeight(opts)
+	record, resp, err := s.GetBlockRecordByHeightWithContext(ctx, opts)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -200,10 +245,560 @@ func (s *FullNodeService) GetBlockByHeight(opts *GetBlockByHeightOptions) (*GetB
 
 	// Get Full Block
 	block := &GetBlockResponse{}
-	resp, err = s.Do(request, block)
+	resp, err = s.DoWithContext(ctx, request, block)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return block, resp, nil
 }
+
+// CoinRecordFilterOptions filters common to all of the get_coin_records_by_* rpc calls
+type CoinRecordFilterOptions struct {
+	StartHeight       *uint32 `json:"start_height,omitempty"`
+	EndHeight         *uint32 `json:"end_height,omitempty"`
+	IncludeSpentCoins bool    `json:"include_spent_coins"`
+}
+
+// GetCoinRecordsResponse response for the get_coin_records_by_* rpc calls
+type GetCoinRecordsResponse struct {
+	rpcinterface.Response
+	CoinRecords []*types.CoinRecord `json:"coin_records"`
+}
+
+// GetCoinRecordsByPuzzleHashOptions options for get_coin_records_by_puzzle_hash
+type GetCoinRecordsByPuzzleHashOptions struct {
+	PuzzleHash types.PuzzleHash `json:"puzzle_hash"`
+	CoinRecordFilterOptions
+}
+
+// GetCoinRecordsByPuzzleHash full_node->get_coin_records_by_puzzle_hash RPC method
+func (s *FullNodeService) GetCoinRecordsByPuzzleHash(opts *GetCoinRecordsByPuzzleHashOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	return s.GetCoinRecordsByPuzzleHashWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordsByPuzzleHashWithContext is the same as GetCoinRecordsByPuzzleHash, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordsByPuzzleHashWithContext(ctx context.Context, opts *GetCoinRecordsByPuzzleHashOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_records_by_puzzle_hash", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCoinRecordsByPuzzleHashesOptions options for get_coin_records_by_puzzle_hashes
+type GetCoinRecordsByPuzzleHashesOptions struct {
+	PuzzleHashes []types.PuzzleHash `json:"puzzle_hashes"`
+	CoinRecordFilterOptions
+}
+
+// GetCoinRecordsByPuzzleHashes full_node->get_coin_records_by_puzzle_hashes RPC method
+func (s *FullNodeService) GetCoinRecordsByPuzzleHashes(opts *GetCoinRecordsByPuzzleHashesOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	return s.GetCoinRecordsByPuzzleHashesWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordsByPuzzleHashesWithContext is the same as GetCoinRecordsByPuzzleHashes, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordsByPuzzleHashesWithContext(ctx context.Context, opts *GetCoinRecordsByPuzzleHashesOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_records_by_puzzle_hashes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCoinRecordByNameOptions options for get_coin_record_by_name
+type GetCoinRecordByNameOptions struct {
+	Name string `json:"name"`
+}
+
+// GetCoinRecordByNameResponse response for get_coin_record_by_name
+type GetCoinRecordByNameResponse struct {
+	rpcinterface.Response
+	CoinRecord *types.CoinRecord `json:"coin_record"`
+}
+
+// GetCoinRecordByName full_node->get_coin_record_by_name RPC method
+func (s *FullNodeService) GetCoinRecordByName(opts *GetCoinRecordByNameOptions) (*GetCoinRecordByNameResponse, *http.Response, error) {
+	return s.GetCoinRecordByNameWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordByNameWithContext is the same as GetCoinRecordByName, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordByNameWithContext(ctx context.Context, opts *GetCoinRecordByNameOptions) (*GetCoinRecordByNameResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_record_by_name", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordByNameResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCoinRecordsByNamesOptions options for get_coin_records_by_names
+type GetCoinRecordsByNamesOptions struct {
+	Names []string `json:"names"`
+	CoinRecordFilterOptions
+}
+
+// GetCoinRecordsByNames full_node->get_coin_records_by_names RPC method
+func (s *FullNodeService) GetCoinRecordsByNames(opts *GetCoinRecordsByNamesOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	return s.GetCoinRecordsByNamesWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordsByNamesWithContext is the same as GetCoinRecordsByNames, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordsByNamesWithContext(ctx context.Context, opts *GetCoinRecordsByNamesOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_records_by_names", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCoinRecordsByParentIDsOptions options for get_coin_records_by_parent_ids
+type GetCoinRecordsByParentIDsOptions struct {
+	ParentIDs []string `json:"parent_ids"`
+	CoinRecordFilterOptions
+}
+
+// GetCoinRecordsByParentIDs full_node->get_coin_records_by_parent_ids RPC method
+func (s *FullNodeService) GetCoinRecordsByParentIDs(opts *GetCoinRecordsByParentIDsOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	return s.GetCoinRecordsByParentIDsWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordsByParentIDsWithContext is the same as GetCoinRecordsByParentIDs, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordsByParentIDsWithContext(ctx context.Context, opts *GetCoinRecordsByParentIDsOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_records_by_parent_ids", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCoinRecordsByHintOptions options for get_coin_records_by_hint
+type GetCoinRecordsByHintOptions struct {
+	Hint string `json:"hint"`
+	CoinRecordFilterOptions
+}
+
+// GetCoinRecordsByHint full_node->get_coin_records_by_hint RPC method
+func (s *FullNodeService) GetCoinRecordsByHint(opts *GetCoinRecordsByHintOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	return s.GetCoinRecordsByHintWithContext(context.Background(), opts)
+}
+
+// GetCoinRecordsByHintWithContext is the same as GetCoinRecordsByHint, but the request is bound to ctx
+func (s *FullNodeService) GetCoinRecordsByHintWithContext(ctx context.Context, opts *GetCoinRecordsByHintOptions) (*GetCoinRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_coin_records_by_hint", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCoinRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PushTXOptions options for push_tx
+type PushTXOptions struct {
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+}
+
+// PushTXResponse response for push_tx
+// A spend bundle that fails to enter the mempool returns an error instead of MempoolInclusionStatusFailed
+type PushTXResponse struct {
+	rpcinterface.Response
+	Status types.MempoolInclusionStatus `json:"status"`
+}
+
+// PushTX full_node->push_tx RPC method submits a spend bundle to the mempool
+func (s *FullNodeService) PushTX(opts *PushTXOptions) (*PushTXResponse, *http.Response, error) {
+	return s.PushTXWithContext(context.Background(), opts)
+}
+
+// PushTXWithContext is the same as PushTX, but the request is bound to ctx
+func (s *FullNodeService) PushTXWithContext(ctx context.Context, opts *PushTXOptions) (*PushTXResponse, *http.Response, error) {
+	request, err := s.NewRequest("push_tx", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PushTXResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetAllMempoolTxIDsResponse response for get_all_mempool_tx_ids
+type GetAllMempoolTxIDsResponse struct {
+	rpcinterface.Response
+	TxIDs []string `json:"tx_ids"`
+}
+
+// GetAllMempoolTxIDs full_node->get_all_mempool_tx_ids RPC method
+func (s *FullNodeService) GetAllMempoolTxIDs() (*GetAllMempoolTxIDsResponse, *http.Response, error) {
+	return s.GetAllMempoolTxIDsWithContext(context.Background())
+}
+
+// GetAllMempoolTxIDsWithContext is the same as GetAllMempoolTxIDs, but the request is bound to ctx
+func (s *FullNodeService) GetAllMempoolTxIDsWithContext(ctx context.Context) (*GetAllMempoolTxIDsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_all_mempool_tx_ids", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetAllMempoolTxIDsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetAllMempoolItemsResponse response for get_all_mempool_items
+type GetAllMempoolItemsResponse struct {
+	rpcinterface.Response
+	MempoolItems map[string]*types.MempoolItem `json:"mempool_items"`
+}
+
+// GetAllMempoolItems full_node->get_all_mempool_items RPC method returns every mempool item keyed by tx id
+func (s *FullNodeService) GetAllMempoolItems() (*GetAllMempoolItemsResponse, *http.Response, error) {
+	return s.GetAllMempoolItemsWithContext(context.Background())
+}
+
+// GetAllMempoolItemsWithContext is the same as GetAllMempoolItems, but the request is bound to ctx
+func (s *FullNodeService) GetAllMempoolItemsWithContext(ctx context.Context) (*GetAllMempoolItemsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_all_mempool_items", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetAllMempoolItemsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetMempoolItemByTxIDOptions options for get_mempool_item_by_tx_id
+type GetMempoolItemByTxIDOptions struct {
+	TxID string `json:"tx_id"`
+}
+
+// GetMempoolItemByTxIDResponse response for get_mempool_item_by_tx_id
+type GetMempoolItemByTxIDResponse struct {
+	rpcinterface.Response
+	MempoolItem *types.MempoolItem `json:"mempool_item"`
+}
+
+// GetMempoolItemByTxID full_node->get_mempool_item_by_tx_id RPC method
+func (s *FullNodeService) GetMempoolItemByTxID(opts *GetMempoolItemByTxIDOptions) (*GetMempoolItemByTxIDResponse, *http.Response, error) {
+	return s.GetMempoolItemByTxIDWithContext(context.Background(), opts)
+}
+
+// GetMempoolItemByTxIDWithContext is the same as GetMempoolItemByTxID, but the request is bound to ctx
+func (s *FullNodeService) GetMempoolItemByTxIDWithContext(ctx context.Context, opts *GetMempoolItemByTxIDOptions) (*GetMempoolItemByTxIDResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_mempool_item_by_tx_id", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetMempoolItemByTxIDResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetBlockRecordsOptions options for get_block_records
+type GetBlockRecordsOptions struct {
+	Start uint32 `json:"start"`
+	End   uint32 `json:"end"`
+}
+
+// GetBlockRecordsResponse response from get_block_records
+type GetBlockRecordsResponse struct {
+	rpcinterface.Response
+	BlockRecords []*types.BlockRecord `json:"block_records"`
+}
+
+// GetBlockRecords full_node->get_block_records RPC method returns the block records from start (inclusive) to end (exclusive)
+func (s *FullNodeService) GetBlockRecords(opts *GetBlockRecordsOptions) (*GetBlockRecordsResponse, *http.Response, error) {
+	return s.GetBlockRecordsWithContext(context.Background(), opts)
+}
+
+// GetBlockRecordsWithContext is the same as GetBlockRecords, but the request is bound to ctx
+func (s *FullNodeService) GetBlockRecordsWithContext(ctx context.Context, opts *GetBlockRecordsOptions) (*GetBlockRecordsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_block_records", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetBlockRecordsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetBlockRecordOptions options for get_block_record
+type GetBlockRecordOptions struct {
+	HeaderHash string `json:"header_hash"`
+}
+
+// GetBlockRecord full_node->get_block_record RPC method
+func (s *FullNodeService) GetBlockRecord(opts *GetBlockRecordOptions) (*GetBlockRecordResponse, *http.Response, error) {
+	return s.GetBlockRecordWithContext(context.Background(), opts)
+}
+
+// GetBlockRecordWithContext is the same as GetBlockRecord, but the request is bound to ctx
+func (s *FullNodeService) GetBlockRecordWithContext(ctx context.Context, opts *GetBlockRecordOptions) (*GetBlockRecordResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_block_record", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetBlockRecordResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetUnfinishedBlockHeadersResponse response from get_unfinished_block_headers
+type GetUnfinishedBlockHeadersResponse struct {
+	rpcinterface.Response
+	Headers []*types.UnfinishedHeaderBlock `json:"headers"`
+}
+
+// GetUnfinishedBlockHeaders full_node->get_unfinished_block_headers RPC method
+func (s *FullNodeService) GetUnfinishedBlockHeaders() (*GetUnfinishedBlockHeadersResponse, *http.Response, error) {
+	return s.GetUnfinishedBlockHeadersWithContext(context.Background())
+}
+
+// GetUnfinishedBlockHeadersWithContext is the same as GetUnfinishedBlockHeaders, but the request is bound to ctx
+func (s *FullNodeService) GetUnfinishedBlockHeadersWithContext(ctx context.Context) (*GetUnfinishedBlockHeadersResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_unfinished_block_headers", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetUnfinishedBlockHeadersResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetAdditionsAndRemovalsOptions options for get_additions_and_removals
+type GetAdditionsAndRemovalsOptions struct {
+	HeaderHash string `json:"header_hash"`
+}
+
+// GetAdditionsAndRemovalsResponse response from get_additions_and_removals
+type GetAdditionsAndRemovalsResponse struct {
+	rpcinterface.Response
+	Additions []*types.CoinRecord `json:"additions"`
+	Removals  []*types.CoinRecord `json:"removals"`
+}
+
+// GetAdditionsAndRemovals full_node->get_additions_and_removals RPC method returns the coins created and spent in a block
+func (s *FullNodeService) GetAdditionsAndRemovals(opts *GetAdditionsAndRemovalsOptions) (*GetAdditionsAndRemovalsResponse, *http.Response, error) {
+	return s.GetAdditionsAndRemovalsWithContext(context.Background(), opts)
+}
+
+// GetAdditionsAndRemovalsWithContext is the same as GetAdditionsAndRemovals, but the request is bound to ctx
+func (s *FullNodeService) GetAdditionsAndRemovalsWithContext(ctx context.Context, opts *GetAdditionsAndRemovalsOptions) (*GetAdditionsAndRemovalsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_additions_and_removals", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetAdditionsAndRemovalsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPuzzleAndSolutionOptions options for get_puzzle_and_solution
+type GetPuzzleAndSolutionOptions struct {
+	CoinID string `json:"coin_id"`
+	Height uint32 `json:"height"`
+}
+
+// GetPuzzleAndSolutionResponse response from get_puzzle_and_solution
+type GetPuzzleAndSolutionResponse struct {
+	rpcinterface.Response
+	CoinSolution *types.CoinSolution `json:"coin_solution"`
+}
+
+// GetPuzzleAndSolution full_node->get_puzzle_and_solution RPC method returns the spend of a coin at the height it was spent
+func (s *FullNodeService) GetPuzzleAndSolution(opts *GetPuzzleAndSolutionOptions) (*GetPuzzleAndSolutionResponse, *http.Response, error) {
+	return s.GetPuzzleAndSolutionWithContext(context.Background(), opts)
+}
+
+// GetPuzzleAndSolutionWithContext is the same as GetPuzzleAndSolution, but the request is bound to ctx
+func (s *FullNodeService) GetPuzzleAndSolutionWithContext(ctx context.Context, opts *GetPuzzleAndSolutionOptions) (*GetPuzzleAndSolutionResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_puzzle_and_solution", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPuzzleAndSolutionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetRecentSignagePointOrEOSOptions options for get_recent_signage_point_or_eos
+// Only one of SPHash or ChallengeHash should be set
+type GetRecentSignagePointOrEOSOptions struct {
+	SPHash        string `json:"sp_hash,omitempty"`
+	ChallengeHash string `json:"challenge_hash,omitempty"`
+}
+
+// GetRecentSignagePointOrEOSResponse response from get_recent_signage_point_or_eos
+// SignagePoint is set when requested by SPHash, and EOS is set when requested by ChallengeHash
+type GetRecentSignagePointOrEOSResponse struct {
+	rpcinterface.Response
+	SignagePoint *types.SignagePoint       `json:"signage_point"`
+	EOS          *types.EndOfSubSlotBundle `json:"eos"`
+	TimeReceived float64                   `json:"time_received"` // @TODO time.Time?
+	Reverted     bool                      `json:"reverted"`
+}
+
+// GetRecentSignagePointOrEOS full_node->get_recent_signage_point_or_eos RPC method
+func (s *FullNodeService) GetRecentSignagePointOrEOS(opts *GetRecentSignagePointOrEOSOptions) (*GetRecentSignagePointOrEOSResponse, *http.Response, error) {
+	return s.GetRecentSignagePointOrEOSWithContext(context.Background(), opts)
+}
+
+// GetRecentSignagePointOrEOSWithContext is the same as GetRecentSignagePointOrEOS, but the request is bound to ctx
+func (s *FullNodeService) GetRecentSignagePointOrEOSWithContext(ctx context.Context, opts *GetRecentSignagePointOrEOSOptions) (*GetRecentSignagePointOrEOSResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_recent_signage_point_or_eos", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetRecentSignagePointOrEOSResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetNetworkSpaceOptions options for get_network_space
+type GetNetworkSpaceOptions struct {
+	NewerBlockHeaderHash string `json:"newer_block_header_hash"`
+	OlderBlockHeaderHash string `json:"older_block_header_hash"`
+}
+
+// GetNetworkSpaceResponse response from get_network_space
+type GetNetworkSpaceResponse struct {
+	rpcinterface.Response
+	Space types.Uint128 `json:"space"`
+}
+
+// GetNetworkSpace full_node->get_network_space RPC method estimates the network space between two blocks
+func (s *FullNodeService) GetNetworkSpace(opts *GetNetworkSpaceOptions) (*GetNetworkSpaceResponse, *http.Response, error) {
+	return s.GetNetworkSpaceWithContext(context.Background(), opts)
+}
+
+// GetNetworkSpaceWithContext is the same as GetNetworkSpace, but the request is bound to ctx
+func (s *FullNodeService) GetNetworkSpaceWithContext(ctx context.Context, opts *GetNetworkSpaceOptions) (*GetNetworkSpaceResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_network_space", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetNetworkSpaceResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetNetworkInfoResponse response from get_network_info
+type GetNetworkInfoResponse struct {
+	rpcinterface.Response
+	NetworkName   string `json:"network_name"`
+	NetworkPrefix string `json:"network_prefix"`
+}
+
+// GetNetworkInfo full_node->get_network_info RPC method
+func (s *FullNodeService) GetNetworkInfo() (*GetNetworkInfoResponse, *http.Response, error) {
+	return s.GetNetworkInfoWithContext(context.Background())
+}
+
+// GetNetworkInfoWithContext is the same as GetNetworkInfo, but the request is bound to ctx
+func (s *FullNodeService) GetNetworkInfoWithContext(ctx context.Context) (*GetNetworkInfoResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_network_info", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetNetworkInfoResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
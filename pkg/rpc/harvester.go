@@ -0,0 +1,194 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// HarvesterService encapsulates harvester RPC methods
+type HarvesterService struct {
+	client *Client
+}
+
+// NewRequest returns a new request specific to the harvester service
+func (s *HarvesterService) NewRequest(rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
+	return s.client.NewRequest(rpcinterface.ServiceHarvester, rpcEndpoint, opt)
+}
+
+// Do is just a shortcut to the client's Do method
+func (s *HarvesterService) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.Do(req, v)
+}
+
+// DoWithContext is just a shortcut to the client's DoWithContext method
+func (s *HarvesterService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	return s.client.DoWithContext(ctx, req, v)
+}
+
+// GetPlotsResponse response for get_plots on harvester
+type GetPlotsResponse struct {
+	rpcinterface.Response
+	Plots                 []*types.PlotInfo `json:"plots"`
+	FailedToOpenFilenames []string          `json:"failed_to_open_filenames"`
+	NotFoundFilenames     []string          `json:"not_found_filenames"`
+}
+
+// GetPlots harvester rpc -> get_plots
+func (s *HarvesterService) GetPlots() (*GetPlotsResponse, *http.Response, error) {
+	return s.GetPlotsWithContext(context.Background())
+}
+
+// GetPlotsWithContext is the same as GetPlots, but the request is bound to ctx
+func (s *HarvesterService) GetPlotsWithContext(ctx context.Context) (*GetPlotsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_plots", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPlotsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// RefreshPlotsResponse response for refresh_plots on harvester
+type RefreshPlotsResponse struct {
+	rpcinterface.Response
+}
+
+// RefreshPlots harvester rpc -> refresh_plots triggers a refresh of the plots in all plot directories
+func (s *HarvesterService) RefreshPlots() (*RefreshPlotsResponse, *http.Response, error) {
+	return s.RefreshPlotsWithContext(context.Background())
+}
+
+// RefreshPlotsWithContext is the same as RefreshPlots, but the request is bound to ctx
+func (s *HarvesterService) RefreshPlotsWithContext(ctx context.Context) (*RefreshPlotsResponse, *http.Response, error) {
+	request, err := s.NewRequest("refresh_plots", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &RefreshPlotsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeletePlotOptions options for delete_plot on harvester
+type DeletePlotOptions struct {
+	Filename string `json:"filename"`
+}
+
+// DeletePlotResponse response for delete_plot on harvester
+type DeletePlotResponse struct {
+	rpcinterface.Response
+}
+
+// DeletePlot harvester rpc -> delete_plot deletes the plot file from disk
+func (s *HarvesterService) DeletePlot(opts *DeletePlotOptions) (*DeletePlotResponse, *http.Response, error) {
+	return s.DeletePlotWithContext(context.Background(), opts)
+}
+
+// DeletePlotWithContext is the same as DeletePlot, but the request is bound to ctx
+func (s *HarvesterService) DeletePlotWithContext(ctx context.Context, opts *DeletePlotOptions) (*DeletePlotResponse, *http.Response, error) {
+	request, err := s.NewRequest("delete_plot", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DeletePlotResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PlotDirectoryOptions options for add_plot_directory and remove_plot_directory on harvester
+type PlotDirectoryOptions struct {
+	Dirname string `json:"dirname"`
+}
+
+// PlotDirectoryResponse response for add_plot_directory and remove_plot_directory on harvester
+type PlotDirectoryResponse struct {
+	rpcinterface.Response
+}
+
+// AddPlotDirectory harvester rpc -> add_plot_directory
+func (s *HarvesterService) AddPlotDirectory(opts *PlotDirectoryOptions) (*PlotDirectoryResponse, *http.Response, error) {
+	return s.AddPlotDirectoryWithContext(context.Background(), opts)
+}
+
+// AddPlotDirectoryWithContext is the same as AddPlotDirectory, but the request is bound to ctx
+func (s *HarvesterService) AddPlotDirectoryWithContext(ctx context.Context, opts *PlotDirectoryOptions) (*PlotDirectoryResponse, *http.Response, error) {
+	request, err := s.NewRequest("add_plot_directory", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PlotDirectoryResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPlotDirectoriesResponse response for get_plot_directories on harvester
+type GetPlotDirectoriesResponse struct {
+	rpcinterface.Response
+	Directories []string `json:"directories"`
+}
+
+// GetPlotDirectories harvester rpc -> get_plot_directories
+func (s *HarvesterService) GetPlotDirectories() (*GetPlotDirectoriesResponse, *http.Response, error) {
+	return s.GetPlotDirectoriesWithContext(context.Background())
+}
+
+// GetPlotDirectoriesWithContext is the same as GetPlotDirectories, but the request is bound to ctx
+func (s *HarvesterService) GetPlotDirectoriesWithContext(ctx context.Context) (*GetPlotDirectoriesResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_plot_directories", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPlotDirectoriesResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// RemovePlotDirectory harvester rpc -> remove_plot_directory
+func (s *HarvesterService) RemovePlotDirectory(opts *PlotDirectoryOptions) (*PlotDirectoryResponse, *http.Response, error) {
+	return s.RemovePlotDirectoryWithContext(context.Background(), opts)
+}
+
+// RemovePlotDirectoryWithContext is the same as RemovePlotDirectory, but the request is bound to ctx
+func (s *HarvesterService) RemovePlotDirectoryWithContext(ctx context.Context, opts *PlotDirectoryOptions) (*PlotDirectoryResponse, *http.Response, error) {
+	request, err := s.NewRequest("remove_plot_directory", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PlotDirectoryResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
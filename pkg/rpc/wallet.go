@@ -1,15 +1,23 @@
 package rpc
 
 import (
-	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"context"
 	"net/http"
+	"sync"
 
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
 	"github.com/cmmarslender/go-chia-rpc/pkg/types"
 )
 
 // WalletService encapsulates wallet RPC methods
 type WalletService struct {
 	client *Client
+
+	// fingerprint is the key wallet requests are pinned to, and fingerprintCheck is when it is checked
+	// See Client.SetWalletFingerprint
+	fingerprint      uint32
+	fingerprintCheck FingerprintCheck
+	fingerprintLock  sync.Mutex
 }
 
 // NewRequest returns a new request specific to the wallet service
@@ -18,27 +26,67 @@ func (s *WalletService) NewRequest(rpcEndpoint rpcinterface.Endpoint, opt interf
 }
 
 // Do is just a shortcut to the client's Do method
+// If a wallet fingerprint is pinned, the wallet is switched to that key first if necessary
 func (s *WalletService) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
-	return s.client.Do(req, v)
+	return s.DoWithContext(context.Background(), req, v)
+}
+
+// DoWithContext is just a shortcut to the client's DoWithContext method
+// If a wallet fingerprint is pinned, the wallet is switched to that key first if necessary, and ErrWalletKeyChanged
+// is returned if the wallet was switched to another key while the request was running
+func (s *WalletService) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	if keyManagementEndpoints[req.Endpoint] {
+		// These change or report the state of the keychain, so a cached response is never correct
+		req.NoCache = true
+		return s.client.DoWithContext(ctx, req, v)
+	}
+
+	fingerprint, check := s.pinnedFingerprint()
+	if fingerprint == 0 {
+		return s.client.DoWithContext(ctx, req, v)
+	}
+
+	err := s.ensureFingerprint(ctx, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DoWithContext(ctx, req, v)
+	if err != nil || check == FingerprintCheckBefore {
+		return resp, err
+	}
+
+	// Another client may have switched keys between the check and the request
+	err = s.checkFingerprint(ctx, fingerprint)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
 }
 
 // GetWalletSyncStatusResponse Response for get_sync_status on wallet
 type GetWalletSyncStatusResponse struct {
+	rpcinterface.Response
 	GenesisInitialized bool `json:"genesis_initialized"`
-	Success            bool `json:"success"`
 	Synced             bool `json:"synced"`
 	Syncing            bool `json:"syncing"`
 }
 
 // GetSyncStatus wallet rpc -> get_sync_status
 func (s *WalletService) GetSyncStatus() (*GetWalletSyncStatusResponse, *http.Response, error) {
+	return s.GetSyncStatusWithContext(context.Background())
+}
+
+// GetSyncStatusWithContext is the same as GetSyncStatus, but the request is bound to ctx
+func (s *WalletService) GetSyncStatusWithContext(ctx context.Context) (*GetWalletSyncStatusResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_sync_status", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletSyncStatusResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -48,19 +96,24 @@ func (s *WalletService) GetSyncStatus() (*GetWalletSyncStatusResponse, *http.Res
 
 // GetWalletHeightInfoResponse response for get_height_info on wallet
 type GetWalletHeightInfoResponse struct {
-	Height  uint32 `json:"height"`
-	Success bool   `json:"success"`
+	rpcinterface.Response
+	Height uint32 `json:"height"`
 }
 
 // GetHeightInfo wallet rpc -> get_height_info
 func (s *WalletService) GetHeightInfo() (*GetWalletHeightInfoResponse, *http.Response, error) {
+	return s.GetHeightInfoWithContext(context.Background())
+}
+
+// GetHeightInfoWithContext is the same as GetHeightInfo, but the request is bound to ctx
+func (s *WalletService) GetHeightInfoWithContext(ctx context.Context) (*GetWalletHeightInfoResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_height_info", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletHeightInfoResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -70,20 +123,25 @@ func (s *WalletService) GetHeightInfo() (*GetWalletHeightInfoResponse, *http.Res
 
 // GetWalletNetworkInfoResponse response for get_height_info on wallet
 type GetWalletNetworkInfoResponse struct {
+	rpcinterface.Response
 	NetworkName   string `json:"network_name"`
 	NetworkPrefix string `json:"network_prefix"`
-	Success       bool   `json:"success"`
 }
 
 // GetNetworkInfo wallet rpc -> get_network_info
 func (s *WalletService) GetNetworkInfo() (*GetWalletNetworkInfoResponse, *http.Response, error) {
+	return s.GetNetworkInfoWithContext(context.Background())
+}
+
+// GetNetworkInfoWithContext is the same as GetNetworkInfo, but the request is bound to ctx
+func (s *WalletService) GetNetworkInfoWithContext(ctx context.Context) (*GetWalletNetworkInfoResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_network_info", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletNetworkInfoResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -93,19 +151,24 @@ func (s *WalletService) GetNetworkInfo() (*GetWalletNetworkInfoResponse, *http.R
 
 // GetWalletsResponse wallet rpc -> get_wallets
 type GetWalletsResponse struct {
-	Success bool                `json:"success"`
+	rpcinterface.Response
 	Wallets []*types.WalletInfo `json:"wallets"`
 }
 
 // GetWallets wallet rpc -> get_wallets
 func (s *WalletService) GetWallets() (*GetWalletsResponse, *http.Response, error) {
+	return s.GetWalletsWithContext(context.Background())
+}
+
+// GetWalletsWithContext is the same as GetWallets, but the request is bound to ctx
+func (s *WalletService) GetWalletsWithContext(ctx context.Context) (*GetWalletsResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_wallets", nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletsResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -120,19 +183,24 @@ type GetWalletBalanceOptions struct {
 
 // GetWalletBalanceResponse is the wallet balance RPC response
 type GetWalletBalanceResponse struct {
-	Success bool                 `json:"success"`
+	rpcinterface.Response
 	Balance *types.WalletBalance `json:"wallet_balance"`
 }
 
 // GetWalletBalance returns wallet balance
 func (s *WalletService) GetWalletBalance(opts *GetWalletBalanceOptions) (*GetWalletBalanceResponse, *http.Response, error) {
+	return s.GetWalletBalanceWithContext(context.Background(), opts)
+}
+
+// GetWalletBalanceWithContext is the same as GetWalletBalance, but the request is bound to ctx
+func (s *WalletService) GetWalletBalanceWithContext(ctx context.Context, opts *GetWalletBalanceOptions) (*GetWalletBalanceResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_wallet_balance", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletBalanceResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -147,19 +215,25 @@ type GetWalletTransactionCountOptions struct {
 
 // GetWalletTransactionCountResponse response for get_wallet_transactions
 type GetWalletTransactionCountResponse struct {
+	rpcinterface.Response
 	WalletID uint32 `json:"wallet_id"`
 	Count    int    `json:"count"`
 }
 
 // GetTransactionCount returns the total count of transactions for the specific wallet ID
 func (s *WalletService) GetTransactionCount(opts *GetWalletTransactionCountOptions) (*GetWalletTransactionCountResponse, *http.Response, error) {
+	return s.GetTransactionCountWithContext(context.Background(), opts)
+}
+
+// GetTransactionCountWithContext is the same as GetTransactionCount, but the request is bound to ctx
+func (s *WalletService) GetTransactionCountWithContext(ctx context.Context, opts *GetWalletTransactionCountOptions) (*GetWalletTransactionCountResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_transaction_count", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletTransactionCountResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -176,19 +250,25 @@ type GetWalletTransactionsOptions struct {
 
 // GetWalletTransactionsResponse response for get_wallet_transactions
 type GetWalletTransactionsResponse struct {
+	rpcinterface.Response
 	WalletID     uint32                     `json:"wallet_id"`
 	Transactions []*types.TransactionRecord `json:"transactions"`
 }
 
 // GetTransactions wallet rpc -> get_transactions
 func (s *WalletService) GetTransactions(opts *GetWalletTransactionsOptions) (*GetWalletTransactionsResponse, *http.Response, error) {
+	return s.GetTransactionsWithContext(context.Background(), opts)
+}
+
+// GetTransactionsWithContext is the same as GetTransactions, but the request is bound to ctx
+func (s *WalletService) GetTransactionsWithContext(ctx context.Context, opts *GetWalletTransactionsOptions) (*GetWalletTransactionsResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_transactions", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletTransactionsResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -204,19 +284,270 @@ type GetWalletTransactionOptions struct {
 
 // GetWalletTransactionResponse response for get_wallet_transactions
 type GetWalletTransactionResponse struct {
+	rpcinterface.Response
 	Transaction   *types.TransactionRecord `json:"transaction"`
 	TransactionID string                   `json:"transaction_id"`
 }
 
 // GetTransaction returns a single transaction record
 func (s *WalletService) GetTransaction(opts *GetWalletTransactionOptions) (*GetWalletTransactionResponse, *http.Response, error) {
+	return s.GetTransactionWithContext(context.Background(), opts)
+}
+
+// GetTransactionWithContext is the same as GetTransaction, but the request is bound to ctx
+func (s *WalletService) GetTransactionWithContext(ctx context.Context, opts *GetWalletTransactionOptions) (*GetWalletTransactionResponse, *http.Response, error) {
 	request, err := s.NewRequest("get_transaction", opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	r := &GetWalletTransactionResponse{}
-	resp, err := s.Do(request, r)
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetNextAddressOptions options for get_next_address
+type GetNextAddressOptions struct {
+	WalletID   uint32 `json:"wallet_id"`
+	NewAddress bool   `json:"new_address"`
+}
+
+// GetNextAddressResponse response from get_next_address
+type GetNextAddressResponse struct {
+	rpcinterface.Response
+	WalletID uint32        `json:"wallet_id"`
+	Address  types.Address `json:"address"`
+}
+
+// GetNextAddress wallet rpc -> get_next_address returns the current address, or a new one if NewAddress is set
+func (s *WalletService) GetNextAddress(opts *GetNextAddressOptions) (*GetNextAddressResponse, *http.Response, error) {
+	return s.GetNextAddressWithContext(context.Background(), opts)
+}
+
+// GetNextAddressWithContext is the same as GetNextAddress, but the request is bound to ctx
+func (s *WalletService) GetNextAddressWithContext(ctx context.Context, opts *GetNextAddressOptions) (*GetNextAddressResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_next_address", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetNextAddressResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// SendTransactionOptions options for send_transaction
+type SendTransactionOptions struct {
+	WalletID uint32        `json:"wallet_id"`
+	Amount   types.Mojo    `json:"amount"`
+	Fee      types.Mojo    `json:"fee"`
+	Address  types.Address `json:"address"`
+	Memos    []string      `json:"memos,omitempty"`
+}
+
+// SendTransactionResponse response from send_transaction and send_transaction_multi
+type SendTransactionResponse struct {
+	rpcinterface.Response
+	Transaction   *types.TransactionRecord `json:"transaction"`
+	TransactionID string                   `json:"transaction_id"`
+}
+
+// SendTransaction wallet rpc -> send_transaction
+func (s *WalletService) SendTransaction(opts *SendTransactionOptions) (*SendTransactionResponse, *http.Response, error) {
+	return s.SendTransactionWithContext(context.Background(), opts)
+}
+
+// SendTransactionWithContext is the same as SendTransaction, but the request is bound to ctx
+func (s *WalletService) SendTransactionWithContext(ctx context.Context, opts *SendTransactionOptions) (*SendTransactionResponse, *http.Response, error) {
+	request, err := s.NewRequest("send_transaction", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SendTransactionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// TransactionAddition a single output when sending a transaction with multiple outputs
+type TransactionAddition struct {
+	Amount     types.Mojo       `json:"amount"`
+	PuzzleHash types.PuzzleHash `json:"puzzle_hash"`
+	Memos      []string         `json:"memos,omitempty"`
+}
+
+// SendTransactionMultiOptions options for send_transaction_multi
+type SendTransactionMultiOptions struct {
+	WalletID  uint32                 `json:"wallet_id"`
+	Additions []*TransactionAddition `json:"additions"`
+	Fee       types.Mojo             `json:"fee"`
+	Coins     []*types.Coin          `json:"coins,omitempty"`
+}
+
+// SendTransactionMulti wallet rpc -> send_transaction_multi sends a single transaction with multiple outputs
+func (s *WalletService) SendTransactionMulti(opts *SendTransactionMultiOptions) (*SendTransactionResponse, *http.Response, error) {
+	return s.SendTransactionMultiWithContext(context.Background(), opts)
+}
+
+// SendTransactionMultiWithContext is the same as SendTransactionMulti, but the request is bound to ctx
+func (s *WalletService) SendTransactionMultiWithContext(ctx context.Context, opts *SendTransactionMultiOptions) (*SendTransactionResponse, *http.Response, error) {
+	request, err := s.NewRequest("send_transaction_multi", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SendTransactionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CreateSignedTransactionOptions options for create_signed_transaction
+// Coins selects the exact coins to spend, otherwise the wallet selects the coins
+type CreateSignedTransactionOptions struct {
+	WalletID      *uint32                `json:"wallet_id,omitempty"`
+	Additions     []*TransactionAddition `json:"additions"`
+	Fee           types.Mojo             `json:"fee"`
+	Coins         []*types.Coin          `json:"coins,omitempty"`
+	MinCoinAmount types.Mojo             `json:"min_coin_amount,omitempty"`
+}
+
+// CreateSignedTransactionResponse response from create_signed_transaction
+// The transaction is signed, but not submitted to the network
+type CreateSignedTransactionResponse struct {
+	rpcinterface.Response
+	SignedTX *types.TransactionRecord `json:"signed_tx"`
+}
+
+// CreateSignedTransaction wallet rpc -> create_signed_transaction
+func (s *WalletService) CreateSignedTransaction(opts *CreateSignedTransactionOptions) (*CreateSignedTransactionResponse, *http.Response, error) {
+	return s.CreateSignedTransactionWithContext(context.Background(), opts)
+}
+
+// CreateSignedTransactionWithContext is the same as CreateSignedTransaction, but the request is bound to ctx
+func (s *WalletService) CreateSignedTransactionWithContext(ctx context.Context, opts *CreateSignedTransactionOptions) (*CreateSignedTransactionResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_signed_transaction", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateSignedTransactionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteUnconfirmedTransactionsOptions options for delete_unconfirmed_transactions
+type DeleteUnconfirmedTransactionsOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// DeleteUnconfirmedTransactionsResponse response from delete_unconfirmed_transactions
+type DeleteUnconfirmedTransactionsResponse struct {
+	rpcinterface.Response
+}
+
+// DeleteUnconfirmedTransactions wallet rpc -> delete_unconfirmed_transactions
+func (s *WalletService) DeleteUnconfirmedTransactions(opts *DeleteUnconfirmedTransactionsOptions) (*DeleteUnconfirmedTransactionsResponse, *http.Response, error) {
+	return s.DeleteUnconfirmedTransactionsWithContext(context.Background(), opts)
+}
+
+// DeleteUnconfirmedTransactionsWithContext is the same as DeleteUnconfirmedTransactions, but the request is bound to ctx
+func (s *WalletService) DeleteUnconfirmedTransactionsWithContext(ctx context.Context, opts *DeleteUnconfirmedTransactionsOptions) (*DeleteUnconfirmedTransactionsResponse, *http.Response, error) {
+	request, err := s.NewRequest("delete_unconfirmed_transactions", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DeleteUnconfirmedTransactionsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetFarmedAmountResponse response from get_farmed_amount
+type GetFarmedAmountResponse struct {
+	rpcinterface.Response
+	FarmedAmount       types.Mojo `json:"farmed_amount"`
+	PoolRewardAmount   types.Mojo `json:"pool_reward_amount"`
+	FarmerRewardAmount types.Mojo `json:"farmer_reward_amount"`
+	FeeAmount          types.Mojo `json:"fee_amount"`
+	LastHeightFarmed   uint32     `json:"last_height_farmed"`
+}
+
+// GetFarmedAmount wallet rpc -> get_farmed_amount
+func (s *WalletService) GetFarmedAmount() (*GetFarmedAmountResponse, *http.Response, error) {
+	return s.GetFarmedAmountWithContext(context.Background())
+}
+
+// GetFarmedAmountWithContext is the same as GetFarmedAmount, but the request is bound to ctx
+func (s *WalletService) GetFarmedAmountWithContext(ctx context.Context) (*GetFarmedAmountResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_farmed_amount", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetFarmedAmountResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetSpendableCoinsOptions options for get_spendable_coins
+type GetSpendableCoinsOptions struct {
+	WalletID            uint32       `json:"wallet_id"`
+	MinCoinAmount       types.Mojo   `json:"min_coin_amount,omitempty"`
+	MaxCoinAmount       types.Mojo   `json:"max_coin_amount,omitempty"`
+	ExcludedCoinAmounts []types.Mojo `json:"excluded_coin_amounts,omitempty"`
+	ExcludedCoinIDs     []string     `json:"excluded_coin_ids,omitempty"`
+}
+
+// GetSpendableCoinsResponse response from get_spendable_coins
+type GetSpendableCoinsResponse struct {
+	rpcinterface.Response
+	ConfirmedRecords     []*types.CoinRecord `json:"confirmed_records"`
+	UnconfirmedRemovals  []*types.CoinRecord `json:"unconfirmed_removals"`
+	UnconfirmedAdditions []*types.Coin       `json:"unconfirmed_additions"`
+}
+
+// GetSpendableCoins wallet rpc -> get_spendable_coins
+func (s *WalletService) GetSpendableCoins(opts *GetSpendableCoinsOptions) (*GetSpendableCoinsResponse, *http.Response, error) {
+	return s.GetSpendableCoinsWithContext(context.Background(), opts)
+}
+
+// GetSpendableCoinsWithContext is the same as GetSpendableCoins, but the request is bound to ctx
+func (s *WalletService) GetSpendableCoinsWithContext(ctx context.Context, opts *GetSpendableCoinsOptions) (*GetSpendableCoinsResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_spendable_coins", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetSpendableCoinsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
 	if err != nil {
 		return nil, resp, err
 	}
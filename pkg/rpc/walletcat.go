@@ -0,0 +1,238 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// CreateNewCATWalletOptions options for creating a CAT wallet for an existing asset with create_new_wallet
+type CreateNewCATWalletOptions struct {
+	AssetID string `json:"asset_id"`
+}
+
+// CreateNewCATWalletResponse response from create_new_wallet for a CAT wallet
+type CreateNewCATWalletResponse struct {
+	rpcinterface.Response
+	Type     types.WalletType `json:"type"`
+	AssetID  string           `json:"asset_id"`
+	WalletID uint32           `json:"wallet_id"`
+}
+
+// CreateNewCATWallet wallet rpc -> create_new_wallet creates a wallet for an existing CAT
+func (s *WalletService) CreateNewCATWallet(opts *CreateNewCATWalletOptions) (*CreateNewCATWalletResponse, *http.Response, error) {
+	return s.CreateNewCATWalletWithContext(context.Background(), opts)
+}
+
+// CreateNewCATWalletWithContext is the same as CreateNewCATWallet, but the request is bound to ctx
+func (s *WalletService) CreateNewCATWalletWithContext(ctx context.Context, opts *CreateNewCATWalletOptions) (*CreateNewCATWalletResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_new_wallet", struct {
+		WalletType string `json:"wallet_type"`
+		Mode       string `json:"mode"`
+		*CreateNewCATWalletOptions
+	}{
+		WalletType:                "cat_wallet",
+		Mode:                      "existing",
+		CreateNewCATWalletOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateNewCATWalletResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CATSetNameOptions options for cat_set_name
+type CATSetNameOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+	Name     string `json:"name"`
+}
+
+// CATSetNameResponse response from cat_set_name
+type CATSetNameResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// CATSetName wallet rpc -> cat_set_name
+func (s *WalletService) CATSetName(opts *CATSetNameOptions) (*CATSetNameResponse, *http.Response, error) {
+	return s.CATSetNameWithContext(context.Background(), opts)
+}
+
+// CATSetNameWithContext is the same as CATSetName, but the request is bound to ctx
+func (s *WalletService) CATSetNameWithContext(ctx context.Context, opts *CATSetNameOptions) (*CATSetNameResponse, *http.Response, error) {
+	request, err := s.NewRequest("cat_set_name", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CATSetNameResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CATWalletOptions options for CAT calls that only need the wallet ID
+// cat_get_name and cat_get_asset_id
+type CATWalletOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// CATGetNameResponse response from cat_get_name
+type CATGetNameResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+	Name     string `json:"name"`
+}
+
+// CATGetName wallet rpc -> cat_get_name
+func (s *WalletService) CATGetName(opts *CATWalletOptions) (*CATGetNameResponse, *http.Response, error) {
+	return s.CATGetNameWithContext(context.Background(), opts)
+}
+
+// CATGetNameWithContext is the same as CATGetName, but the request is bound to ctx
+func (s *WalletService) CATGetNameWithContext(ctx context.Context, opts *CATWalletOptions) (*CATGetNameResponse, *http.Response, error) {
+	request, err := s.NewRequest("cat_get_name", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CATGetNameResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CATGetAssetIDResponse response from cat_get_asset_id
+type CATGetAssetIDResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+	AssetID  string `json:"asset_id"`
+}
+
+// CATGetAssetID wallet rpc -> cat_get_asset_id
+func (s *WalletService) CATGetAssetID(opts *CATWalletOptions) (*CATGetAssetIDResponse, *http.Response, error) {
+	return s.CATGetAssetIDWithContext(context.Background(), opts)
+}
+
+// CATGetAssetIDWithContext is the same as CATGetAssetID, but the request is bound to ctx
+func (s *WalletService) CATGetAssetIDWithContext(ctx context.Context, opts *CATWalletOptions) (*CATGetAssetIDResponse, *http.Response, error) {
+	request, err := s.NewRequest("cat_get_asset_id", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CATGetAssetIDResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CATSpendOptions options for cat_spend
+// Amount is in CAT mojos, while the fee is paid in XCH mojos
+type CATSpendOptions struct {
+	WalletID     uint32        `json:"wallet_id"`
+	InnerAddress types.Address `json:"inner_address"`
+	Amount       types.CATMojo `json:"amount"`
+	Fee          types.Mojo    `json:"fee"`
+	Memos        []string      `json:"memos,omitempty"`
+}
+
+// CATSpend wallet rpc -> cat_spend
+func (s *WalletService) CATSpend(opts *CATSpendOptions) (*SendTransactionResponse, *http.Response, error) {
+	return s.CATSpendWithContext(context.Background(), opts)
+}
+
+// CATSpendWithContext is the same as CATSpend, but the request is bound to ctx
+func (s *WalletService) CATSpendWithContext(ctx context.Context, opts *CATSpendOptions) (*SendTransactionResponse, *http.Response, error) {
+	request, err := s.NewRequest("cat_spend", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SendTransactionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetCATListResponse response from get_cat_list
+type GetCATListResponse struct {
+	rpcinterface.Response
+	CATList []*types.CATInfo `json:"cat_list"`
+}
+
+// GetCATList wallet rpc -> get_cat_list returns the list of well known CATs
+func (s *WalletService) GetCATList() (*GetCATListResponse, *http.Response, error) {
+	return s.GetCATListWithContext(context.Background())
+}
+
+// GetCATListWithContext is the same as GetCATList, but the request is bound to ctx
+func (s *WalletService) GetCATListWithContext(ctx context.Context) (*GetCATListResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_cat_list", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetCATListResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CATAssetIDToNameOptions options for cat_asset_id_to_name
+type CATAssetIDToNameOptions struct {
+	AssetID string `json:"asset_id"`
+}
+
+// CATAssetIDToNameResponse response from cat_asset_id_to_name
+// WalletID is only set if there is a wallet for the asset
+type CATAssetIDToNameResponse struct {
+	rpcinterface.Response
+	WalletID *uint32 `json:"wallet_id"`
+	Name     string  `json:"name"`
+}
+
+// CATAssetIDToName wallet rpc -> cat_asset_id_to_name
+func (s *WalletService) CATAssetIDToName(opts *CATAssetIDToNameOptions) (*CATAssetIDToNameResponse, *http.Response, error) {
+	return s.CATAssetIDToNameWithContext(context.Background(), opts)
+}
+
+// CATAssetIDToNameWithContext is the same as CATAssetIDToName, but the request is bound to ctx
+func (s *WalletService) CATAssetIDToNameWithContext(ctx context.Context, opts *CATAssetIDToNameOptions) (*CATAssetIDToNameResponse, *http.Response, error) {
+	request, err := s.NewRequest("cat_asset_id_to_name", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CATAssetIDToNameResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
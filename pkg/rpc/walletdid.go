@@ -0,0 +1,353 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// CreateNewDIDWalletOptions options for creating a new DID with create_new_wallet
+type CreateNewDIDWalletOptions struct {
+	Amount               types.Mojo        `json:"amount"`
+	Fee                  types.Mojo        `json:"fee"`
+	BackupDIDs           []string          `json:"backup_dids"`
+	NumOfBackupIDsNeeded uint64            `json:"num_of_backup_ids_needed"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	WalletName           string            `json:"wallet_name,omitempty"`
+}
+
+// CreateNewDIDWalletResponse response from create_new_wallet for a DID wallet
+type CreateNewDIDWalletResponse struct {
+	rpcinterface.Response
+	Type     types.WalletType `json:"type"`
+	MyDID    string           `json:"my_did"`
+	WalletID uint32           `json:"wallet_id"`
+}
+
+// CreateNewDIDWallet wallet rpc -> create_new_wallet creates a new DID and a wallet for it
+func (s *WalletService) CreateNewDIDWallet(opts *CreateNewDIDWalletOptions) (*CreateNewDIDWalletResponse, *http.Response, error) {
+	return s.CreateNewDIDWalletWithContext(context.Background(), opts)
+}
+
+// CreateNewDIDWalletWithContext is the same as CreateNewDIDWallet, but the request is bound to ctx
+func (s *WalletService) CreateNewDIDWalletWithContext(ctx context.Context, opts *CreateNewDIDWalletOptions) (*CreateNewDIDWalletResponse, *http.Response, error) {
+	// Chia requires backup_dids to be a list, so send an empty list rather than null when there are no backups
+	o := CreateNewDIDWalletOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.BackupDIDs == nil {
+		o.BackupDIDs = []string{}
+	}
+
+	request, err := s.NewRequest("create_new_wallet", struct {
+		WalletType string `json:"wallet_type"`
+		DIDType    string `json:"did_type"`
+		*CreateNewDIDWalletOptions
+	}{
+		WalletType:                "did_wallet",
+		DIDType:                   "new",
+		CreateNewDIDWalletOptions: &o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateNewDIDWalletResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDWalletOptions options for DID calls that only need the wallet ID
+// did_get_did, did_get_metadata and did_get_recovery_list
+type DIDWalletOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// DIDGetDIDResponse response from did_get_did
+type DIDGetDIDResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+	MyDID    string `json:"my_did"`
+	CoinID   string `json:"coin_id"`
+}
+
+// DIDGetDID wallet rpc -> did_get_did
+func (s *WalletService) DIDGetDID(opts *DIDWalletOptions) (*DIDGetDIDResponse, *http.Response, error) {
+	return s.DIDGetDIDWithContext(context.Background(), opts)
+}
+
+// DIDGetDIDWithContext is the same as DIDGetDID, but the request is bound to ctx
+func (s *WalletService) DIDGetDIDWithContext(ctx context.Context, opts *DIDWalletOptions) (*DIDGetDIDResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_get_did", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDGetDIDResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDGetInfoOptions options for did_get_info
+// CoinID may be the DID ID (did:chia:...) or the launcher ID
+// Latest defaults to true when not set, looking up the latest state of the DID on chain
+type DIDGetInfoOptions struct {
+	CoinID string `json:"coin_id"`
+	Latest *bool  `json:"latest,omitempty"`
+}
+
+// DIDGetInfoResponse response from did_get_info
+type DIDGetInfoResponse struct {
+	rpcinterface.Response
+	types.DIDInfo
+}
+
+// DIDGetInfo wallet rpc -> did_get_info
+func (s *WalletService) DIDGetInfo(opts *DIDGetInfoOptions) (*DIDGetInfoResponse, *http.Response, error) {
+	return s.DIDGetInfoWithContext(context.Background(), opts)
+}
+
+// DIDGetInfoWithContext is the same as DIDGetInfo, but the request is bound to ctx
+func (s *WalletService) DIDGetInfoWithContext(ctx context.Context, opts *DIDGetInfoOptions) (*DIDGetInfoResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_get_info", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDGetInfoResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDUpdateMetadataOptions options for did_update_metadata
+type DIDUpdateMetadataOptions struct {
+	WalletID uint32            `json:"wallet_id"`
+	Metadata map[string]string `json:"metadata"`
+	Fee      types.Mojo        `json:"fee"`
+}
+
+// DIDUpdateMetadataResponse response from did_update_metadata
+type DIDUpdateMetadataResponse struct {
+	rpcinterface.Response
+	WalletID    uint32             `json:"wallet_id"`
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+}
+
+// DIDUpdateMetadata wallet rpc -> did_update_metadata
+func (s *WalletService) DIDUpdateMetadata(opts *DIDUpdateMetadataOptions) (*DIDUpdateMetadataResponse, *http.Response, error) {
+	return s.DIDUpdateMetadataWithContext(context.Background(), opts)
+}
+
+// DIDUpdateMetadataWithContext is the same as DIDUpdateMetadata, but the request is bound to ctx
+func (s *WalletService) DIDUpdateMetadataWithContext(ctx context.Context, opts *DIDUpdateMetadataOptions) (*DIDUpdateMetadataResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_update_metadata", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDUpdateMetadataResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDGetMetadataResponse response from did_get_metadata
+type DIDGetMetadataResponse struct {
+	rpcinterface.Response
+	WalletID uint32            `json:"wallet_id"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// DIDGetMetadata wallet rpc -> did_get_metadata
+func (s *WalletService) DIDGetMetadata(opts *DIDWalletOptions) (*DIDGetMetadataResponse, *http.Response, error) {
+	return s.DIDGetMetadataWithContext(context.Background(), opts)
+}
+
+// DIDGetMetadataWithContext is the same as DIDGetMetadata, but the request is bound to ctx
+func (s *WalletService) DIDGetMetadataWithContext(ctx context.Context, opts *DIDWalletOptions) (*DIDGetMetadataResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_get_metadata", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDGetMetadataResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDTransferDIDOptions options for did_transfer_did
+type DIDTransferDIDOptions struct {
+	WalletID         uint32        `json:"wallet_id"`
+	InnerAddress     types.Address `json:"inner_address"`
+	Fee              types.Mojo    `json:"fee"`
+	WithRecoveryInfo bool          `json:"with_recovery_info"`
+}
+
+// DIDTransferDID wallet rpc -> did_transfer_did
+func (s *WalletService) DIDTransferDID(opts *DIDTransferDIDOptions) (*SendTransactionResponse, *http.Response, error) {
+	return s.DIDTransferDIDWithContext(context.Background(), opts)
+}
+
+// DIDTransferDIDWithContext is the same as DIDTransferDID, but the request is bound to ctx
+func (s *WalletService) DIDTransferDIDWithContext(ctx context.Context, opts *DIDTransferDIDOptions) (*SendTransactionResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_transfer_did", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &SendTransactionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDSetWalletNameOptions options for did_set_wallet_name
+type DIDSetWalletNameOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+	Name     string `json:"name"`
+}
+
+// DIDSetWalletNameResponse response from did_set_wallet_name
+type DIDSetWalletNameResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// DIDSetWalletName wallet rpc -> did_set_wallet_name
+func (s *WalletService) DIDSetWalletName(opts *DIDSetWalletNameOptions) (*DIDSetWalletNameResponse, *http.Response, error) {
+	return s.DIDSetWalletNameWithContext(context.Background(), opts)
+}
+
+// DIDSetWalletNameWithContext is the same as DIDSetWalletName, but the request is bound to ctx
+func (s *WalletService) DIDSetWalletNameWithContext(ctx context.Context, opts *DIDSetWalletNameOptions) (*DIDSetWalletNameResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_set_wallet_name", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDSetWalletNameResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDGetRecoveryListResponse response from did_get_recovery_list
+type DIDGetRecoveryListResponse struct {
+	rpcinterface.Response
+	WalletID     uint32   `json:"wallet_id"`
+	RecoveryList []string `json:"recovery_list"`
+	NumRequired  uint64   `json:"num_required"`
+}
+
+// DIDGetRecoveryList wallet rpc -> did_get_recovery_list
+func (s *WalletService) DIDGetRecoveryList(opts *DIDWalletOptions) (*DIDGetRecoveryListResponse, *http.Response, error) {
+	return s.DIDGetRecoveryListWithContext(context.Background(), opts)
+}
+
+// DIDGetRecoveryListWithContext is the same as DIDGetRecoveryList, but the request is bound to ctx
+func (s *WalletService) DIDGetRecoveryListWithContext(ctx context.Context, opts *DIDWalletOptions) (*DIDGetRecoveryListResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_get_recovery_list", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDGetRecoveryListResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDUpdateRecoveryIDsOptions options for did_update_recovery_ids
+type DIDUpdateRecoveryIDsOptions struct {
+	WalletID                 uint32   `json:"wallet_id"`
+	NewList                  []string `json:"new_list"`
+	NumVerificationsRequired uint64   `json:"num_verifications_required,omitempty"`
+}
+
+// DIDUpdateRecoveryIDs wallet rpc -> did_update_recovery_ids
+func (s *WalletService) DIDUpdateRecoveryIDs(opts *DIDUpdateRecoveryIDsOptions) (*EmptyResponse, *http.Response, error) {
+	return s.DIDUpdateRecoveryIDsWithContext(context.Background(), opts)
+}
+
+// DIDUpdateRecoveryIDsWithContext is the same as DIDUpdateRecoveryIDs, but the request is bound to ctx
+func (s *WalletService) DIDUpdateRecoveryIDsWithContext(ctx context.Context, opts *DIDUpdateRecoveryIDsOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_update_recovery_ids", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DIDRecoverySpendOptions options for did_recovery_spend
+// AttestData are the attestations created by the backup DIDs with did_create_attest
+type DIDRecoverySpendOptions struct {
+	WalletID   uint32           `json:"wallet_id"`
+	AttestData []string         `json:"attest_data"`
+	Pubkey     types.G1Element  `json:"pubkey,omitempty"`
+	Puzhash    types.PuzzleHash `json:"puzhash,omitempty"`
+}
+
+// DIDRecoverySpendResponse response from did_recovery_spend
+type DIDRecoverySpendResponse struct {
+	rpcinterface.Response
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+}
+
+// DIDRecoverySpend wallet rpc -> did_recovery_spend recovers a DID using attestations from its backup DIDs
+func (s *WalletService) DIDRecoverySpend(opts *DIDRecoverySpendOptions) (*DIDRecoverySpendResponse, *http.Response, error) {
+	return s.DIDRecoverySpendWithContext(context.Background(), opts)
+}
+
+// DIDRecoverySpendWithContext is the same as DIDRecoverySpend, but the request is bound to ctx
+func (s *WalletService) DIDRecoverySpendWithContext(ctx context.Context, opts *DIDRecoverySpendOptions) (*DIDRecoverySpendResponse, *http.Response, error) {
+	request, err := s.NewRequest("did_recovery_spend", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &DIDRecoverySpendResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
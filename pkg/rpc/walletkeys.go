@@ -0,0 +1,350 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// keyManagementEndpoints are wallet endpoints that don't depend on which key is logged in
+// These skip the fingerprint check when a wallet fingerprint is pinned, and are never served from the request cache
+var keyManagementEndpoints = map[rpcinterface.Endpoint]bool{
+	"log_in":                    true,
+	"get_logged_in_fingerprint": true,
+	"get_public_keys":           true,
+	"get_private_key":           true,
+	"generate_mnemonic":         true,
+	"add_key":                   true,
+	"delete_key":                true,
+	"check_delete_key":          true,
+	"delete_all_keys":           true,
+}
+
+// ErrWalletKeyChanged is returned from wallet requests when a fingerprint is pinned, and the wallet was switched to
+// another key while the request was running, so it may have run against the wrong key
+var ErrWalletKeyChanged = errors.New("wallet switched keys during the request")
+
+// FingerprintCheck determines when the logged in fingerprint is checked for wallet requests, once a fingerprint is
+// pinned with SetWalletFingerprint
+type FingerprintCheck uint8
+
+const (
+	// FingerprintCheckBeforeAndAfter checks the fingerprint before each wallet request, and again after it to detect
+	// the wallet switching keys while the request was running. Each wallet request makes two extra requests
+	FingerprintCheckBeforeAndAfter FingerprintCheck = iota
+
+	// FingerprintCheckBefore only checks the fingerprint before each wallet request. Each wallet request makes one
+	// extra request, but a key switch while the request is running is not detected
+	FingerprintCheckBefore
+)
+
+// SetWalletFingerprint pins all wallet requests to the key with the provided fingerprint
+// Before each wallet request, the logged in fingerprint is checked and log_in is called if the wallet switched keys
+// By default, the fingerprint is checked again after each wallet request, and ErrWalletKeyChanged is returned if the
+// wallet switched keys while the request was running. See SetWalletFingerprintCheck
+// Set to 0 to stop pinning and use whatever key is logged in
+func (c *Client) SetWalletFingerprint(fingerprint uint32) {
+	c.WalletService.fingerprintLock.Lock()
+	defer c.WalletService.fingerprintLock.Unlock()
+
+	c.WalletService.fingerprint = fingerprint
+}
+
+// SetWalletFingerprintCheck sets when the logged in fingerprint is checked for wallet requests, when a fingerprint is
+// pinned. Defaults to FingerprintCheckBeforeAndAfter
+func (c *Client) SetWalletFingerprintCheck(check FingerprintCheck) {
+	c.WalletService.fingerprintLock.Lock()
+	defer c.WalletService.fingerprintLock.Unlock()
+
+	c.WalletService.fingerprintCheck = check
+}
+
+// pinnedFingerprint returns the fingerprint wallet requests are pinned to, or 0 if they aren't pinned, and when to
+// check it
+func (s *WalletService) pinnedFingerprint() (uint32, FingerprintCheck) {
+	s.fingerprintLock.Lock()
+	defer s.fingerprintLock.Unlock()
+
+	return s.fingerprint, s.fingerprintCheck
+}
+
+// ensureFingerprint logs in to the fingerprint, if it is not already logged in
+func (s *WalletService) ensureFingerprint(ctx context.Context, fingerprint uint32) error {
+	loggedIn, _, err := s.GetLoggedInFingerprintWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if loggedIn.Fingerprint == fingerprint {
+		return nil
+	}
+
+	_, _, err = s.LogInWithContext(ctx, &LogInOptions{Fingerprint: fingerprint})
+	return err
+}
+
+// checkFingerprint returns ErrWalletKeyChanged if the wallet is not logged in to the fingerprint
+func (s *WalletService) checkFingerprint(ctx context.Context, fingerprint uint32) error {
+	loggedIn, _, err := s.GetLoggedInFingerprintWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if loggedIn.Fingerprint != fingerprint {
+		return fmt.Errorf("%w: pinned to %d, logged in to %d", ErrWalletKeyChanged, fingerprint, loggedIn.Fingerprint)
+	}
+
+	return nil
+}
+
+// LogInOptions options for log_in
+type LogInOptions struct {
+	Fingerprint uint32 `json:"fingerprint"`
+}
+
+// FingerprintResponse response from calls that return just a fingerprint
+// log_in, get_logged_in_fingerprint, and add_key
+type FingerprintResponse struct {
+	rpcinterface.Response
+	Fingerprint uint32 `json:"fingerprint"`
+}
+
+// LogIn wallet rpc -> log_in switches the wallet to the key with the provided fingerprint
+func (s *WalletService) LogIn(opts *LogInOptions) (*FingerprintResponse, *http.Response, error) {
+	return s.LogInWithContext(context.Background(), opts)
+}
+
+// LogInWithContext is the same as LogIn, but the request is bound to ctx
+func (s *WalletService) LogInWithContext(ctx context.Context, opts *LogInOptions) (*FingerprintResponse, *http.Response, error) {
+	request, err := s.NewRequest("log_in", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &FingerprintResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetLoggedInFingerprint wallet rpc -> get_logged_in_fingerprint
+func (s *WalletService) GetLoggedInFingerprint() (*FingerprintResponse, *http.Response, error) {
+	return s.GetLoggedInFingerprintWithContext(context.Background())
+}
+
+// GetLoggedInFingerprintWithContext is the same as GetLoggedInFingerprint, but the request is bound to ctx
+func (s *WalletService) GetLoggedInFingerprintWithContext(ctx context.Context) (*FingerprintResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_logged_in_fingerprint", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &FingerprintResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetPublicKeysResponse response from get_public_keys
+type GetPublicKeysResponse struct {
+	rpcinterface.Response
+	PublicKeyFingerprints []uint32 `json:"public_key_fingerprints"`
+}
+
+// GetPublicKeys wallet rpc -> get_public_keys returns the fingerprints of all keys in the keychain
+func (s *WalletService) GetPublicKeys() (*GetPublicKeysResponse, *http.Response, error) {
+	return s.GetPublicKeysWithContext(context.Background())
+}
+
+// GetPublicKeysWithContext is the same as GetPublicKeys, but the request is bound to ctx
+func (s *WalletService) GetPublicKeysWithContext(ctx context.Context) (*GetPublicKeysResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_public_keys", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPublicKeysResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// FingerprintOptions options for calls that operate on a single key
+// get_private_key and delete_key
+type FingerprintOptions struct {
+	Fingerprint uint32 `json:"fingerprint"`
+}
+
+// GetPrivateKeyResponse response from get_private_key
+type GetPrivateKeyResponse struct {
+	rpcinterface.Response
+	PrivateKey *types.PrivateKey `json:"private_key"`
+}
+
+// GetPrivateKey wallet rpc -> get_private_key
+func (s *WalletService) GetPrivateKey(opts *FingerprintOptions) (*GetPrivateKeyResponse, *http.Response, error) {
+	return s.GetPrivateKeyWithContext(context.Background(), opts)
+}
+
+// GetPrivateKeyWithContext is the same as GetPrivateKey, but the request is bound to ctx
+func (s *WalletService) GetPrivateKeyWithContext(ctx context.Context, opts *FingerprintOptions) (*GetPrivateKeyResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_private_key", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetPrivateKeyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GenerateMnemonicResponse response from generate_mnemonic
+type GenerateMnemonicResponse struct {
+	rpcinterface.Response
+	Mnemonic []string `json:"mnemonic"`
+}
+
+// GenerateMnemonic wallet rpc -> generate_mnemonic generates a new mnemonic without adding it to the keychain
+func (s *WalletService) GenerateMnemonic() (*GenerateMnemonicResponse, *http.Response, error) {
+	return s.GenerateMnemonicWithContext(context.Background())
+}
+
+// GenerateMnemonicWithContext is the same as GenerateMnemonic, but the request is bound to ctx
+func (s *WalletService) GenerateMnemonicWithContext(ctx context.Context) (*GenerateMnemonicResponse, *http.Response, error) {
+	request, err := s.NewRequest("generate_mnemonic", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GenerateMnemonicResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// AddKeyOptions options for add_key
+type AddKeyOptions struct {
+	Mnemonic []string `json:"mnemonic"`
+}
+
+// AddKey wallet rpc -> add_key adds a key to the keychain from the mnemonic and logs in to it
+func (s *WalletService) AddKey(opts *AddKeyOptions) (*FingerprintResponse, *http.Response, error) {
+	return s.AddKeyWithContext(context.Background(), opts)
+}
+
+// AddKeyWithContext is the same as AddKey, but the request is bound to ctx
+func (s *WalletService) AddKeyWithContext(ctx context.Context, opts *AddKeyOptions) (*FingerprintResponse, *http.Response, error) {
+	request, err := s.NewRequest("add_key", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &FingerprintResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// EmptyResponse response from calls that return nothing other than success
+type EmptyResponse struct {
+	rpcinterface.Response
+}
+
+// DeleteKey wallet rpc -> delete_key
+func (s *WalletService) DeleteKey(opts *FingerprintOptions) (*EmptyResponse, *http.Response, error) {
+	return s.DeleteKeyWithContext(context.Background(), opts)
+}
+
+// DeleteKeyWithContext is the same as DeleteKey, but the request is bound to ctx
+func (s *WalletService) DeleteKeyWithContext(ctx context.Context, opts *FingerprintOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("delete_key", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CheckDeleteKeyOptions options for check_delete_key
+type CheckDeleteKeyOptions struct {
+	Fingerprint   uint32 `json:"fingerprint"`
+	MaxPHToSearch uint32 `json:"max_ph_to_search,omitempty"`
+}
+
+// CheckDeleteKeyResponse response from check_delete_key
+type CheckDeleteKeyResponse struct {
+	rpcinterface.Response
+	Fingerprint          uint32 `json:"fingerprint"`
+	UsedForFarmerRewards bool   `json:"used_for_farmer_rewards"`
+	UsedForPoolRewards   bool   `json:"used_for_pool_rewards"`
+	WalletBalance        bool   `json:"wallet_balance"`
+}
+
+// CheckDeleteKey wallet rpc -> check_delete_key reports whether the key is still in use before it is deleted
+func (s *WalletService) CheckDeleteKey(opts *CheckDeleteKeyOptions) (*CheckDeleteKeyResponse, *http.Response, error) {
+	return s.CheckDeleteKeyWithContext(context.Background(), opts)
+}
+
+// CheckDeleteKeyWithContext is the same as CheckDeleteKey, but the request is bound to ctx
+func (s *WalletService) CheckDeleteKeyWithContext(ctx context.Context, opts *CheckDeleteKeyOptions) (*CheckDeleteKeyResponse, *http.Response, error) {
+	request, err := s.NewRequest("check_delete_key", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CheckDeleteKeyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteAllKeys wallet rpc -> delete_all_keys
+func (s *WalletService) DeleteAllKeys() (*EmptyResponse, *http.Response, error) {
+	return s.DeleteAllKeysWithContext(context.Background())
+}
+
+// DeleteAllKeysWithContext is the same as DeleteAllKeys, but the request is bound to ctx
+func (s *WalletService) DeleteAllKeysWithContext(ctx context.Context) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("delete_all_keys", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
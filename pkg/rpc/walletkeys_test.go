@@ -0,0 +1,212 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/internal/testutil"
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+)
+
+// fakeWallet is a wallet RPC server with a keychain that other clients can switch keys on
+type fakeWallet struct {
+	server *httptest.Server
+
+	lock        sync.Mutex
+	fingerprint uint32
+	logIns      int
+
+	// requests records the fingerprint that was logged in when each request that isn't key management ran
+	requests []uint32
+
+	// switchDuring is logged in to while the next request that isn't key management runs, if set
+	switchDuring uint32
+
+	// checks counts get_logged_in_fingerprint requests, and inFlight and maxInFlight count concurrent ones
+	checks      int
+	inFlight    int
+	maxInFlight int
+}
+
+func newFakeWallet(t *testing.T, fingerprint uint32) *fakeWallet {
+	w := &fakeWallet{fingerprint: fingerprint}
+	w.server = httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		data := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+
+		w.lock.Lock()
+		switch r.URL.Path {
+		case "/get_logged_in_fingerprint":
+			w.checks++
+			w.inFlight++
+			if w.inFlight > w.maxInFlight {
+				w.maxInFlight = w.inFlight
+			}
+			fingerprint := w.fingerprint
+			w.lock.Unlock()
+
+			// Give concurrent checks a chance to overlap
+			time.Sleep(50 * time.Millisecond)
+
+			w.lock.Lock()
+			w.inFlight--
+			data = map[string]interface{}{"fingerprint": fingerprint}
+		case "/log_in":
+			w.fingerprint = uint32(data["fingerprint"].(float64))
+			w.logIns++
+		default:
+			w.requests = append(w.requests, w.fingerprint)
+			if w.switchDuring != 0 {
+				w.fingerprint = w.switchDuring
+				w.switchDuring = 0
+			}
+			data = map[string]interface{}{}
+		}
+		w.lock.Unlock()
+
+		data["success"] = true
+		_ = json.NewEncoder(rw).Encode(data)
+	}))
+	t.Cleanup(w.server.Close)
+
+	return w
+}
+
+// switchKey logs the wallet in to another key, as if another client had called log_in
+func (w *fakeWallet) switchKey(fingerprint uint32) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.fingerprint = fingerprint
+}
+
+// newWalletTestClient returns an HTTP client that sends wallet requests to the fake wallet
+func newWalletTestClient(t *testing.T, w *fakeWallet, options ...rpcinterface.ClientOptionFunc) *Client {
+	host, port := testutil.ServerAddress(t, w.server)
+	certPEM, keyPEM := testutil.KeyPairPEM(t)
+
+	options = append([]rpcinterface.ClientOptionFunc{
+		WithBaseURL(&url.URL{Scheme: "https", Host: host}),
+		WithServicePort(rpcinterface.ServiceWallet, port),
+		WithServiceKeyPair(rpcinterface.ServiceWallet, certPEM, keyPEM),
+	}, options...)
+	client, err := NewClient(ConnectionModeHTTP, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestWalletFingerprintPinning(t *testing.T) {
+	w := newFakeWallet(t, 2)
+
+	// With the cache enabled, a cached get_logged_in_fingerprint or log_in would hide the key switches
+	c := newWalletTestClient(t, w, WithCache(time.Minute))
+	c.SetWalletFingerprint(1)
+
+	if _, _, err := c.WalletService.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	w.switchKey(2)
+	if _, _, err := c.WalletService.GetHeightInfo(); err != nil {
+		t.Fatal(err)
+	}
+	w.switchKey(2)
+	if _, _, err := c.WalletService.GetNetworkInfo(); err != nil {
+		t.Fatal(err)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.logIns != 3 {
+		t.Errorf("expected log_in every time the wallet was switched to another key, got %d log ins", w.logIns)
+	}
+	for i, fingerprint := range w.requests {
+		if fingerprint != 1 {
+			t.Errorf("request %d ran against fingerprint %d", i, fingerprint)
+		}
+	}
+}
+
+func TestWalletFingerprintSwitchedDuringRequest(t *testing.T) {
+	w := newFakeWallet(t, 1)
+	c := newWalletTestClient(t, w)
+	c.SetWalletFingerprint(1)
+
+	w.lock.Lock()
+	w.switchDuring = 2
+	w.lock.Unlock()
+
+	_, _, err := c.WalletService.GetSyncStatus()
+	if !errors.Is(err, ErrWalletKeyChanged) {
+		t.Fatalf("expected ErrWalletKeyChanged, got %v", err)
+	}
+
+	// The next request switches back to the pinned key
+	if _, _, err = c.WalletService.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.fingerprint != 1 || w.requests[1] != 1 {
+		t.Errorf("expected the wallet to be logged back in to fingerprint 1, got %d", w.fingerprint)
+	}
+}
+
+func TestWalletFingerprintConcurrentRequests(t *testing.T) {
+	w := newFakeWallet(t, 1)
+	c := newWalletTestClient(t, w)
+	c.SetWalletFingerprint(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.WalletService.GetSyncStatus(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.maxInFlight < 2 {
+		t.Error("expected fingerprint checks for concurrent requests to run concurrently")
+	}
+}
+
+func TestWalletFingerprintCheckBefore(t *testing.T) {
+	w := newFakeWallet(t, 1)
+	c := newWalletTestClient(t, w)
+	c.SetWalletFingerprint(1)
+	c.SetWalletFingerprintCheck(FingerprintCheckBefore)
+
+	w.lock.Lock()
+	w.switchDuring = 2
+	w.lock.Unlock()
+
+	// The switch during the request isn't detected, but the next request logs back in to the pinned key
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.WalletService.GetSyncStatus(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.checks != 2 {
+		t.Errorf("expected one fingerprint check per request, got %d", w.checks)
+	}
+	if w.logIns != 1 || w.requests[1] != 1 {
+		t.Errorf("expected the second request to log back in to fingerprint 1, got %d log ins", w.logIns)
+	}
+}
@@ -0,0 +1,389 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// CreateNewNFTWalletOptions options for creating an NFT wallet with create_new_wallet
+type CreateNewNFTWalletOptions struct {
+	DIDID string `json:"did_id,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// CreateNewNFTWalletResponse response from create_new_wallet for an NFT wallet
+type CreateNewNFTWalletResponse struct {
+	rpcinterface.Response
+	Type     types.WalletType `json:"type"`
+	WalletID uint32           `json:"wallet_id"`
+}
+
+// CreateNewNFTWallet wallet rpc -> create_new_wallet creates an NFT wallet, optionally tied to a DID
+func (s *WalletService) CreateNewNFTWallet(opts *CreateNewNFTWalletOptions) (*CreateNewNFTWalletResponse, *http.Response, error) {
+	return s.CreateNewNFTWalletWithContext(context.Background(), opts)
+}
+
+// CreateNewNFTWalletWithContext is the same as CreateNewNFTWallet, but the request is bound to ctx
+func (s *WalletService) CreateNewNFTWalletWithContext(ctx context.Context, opts *CreateNewNFTWalletOptions) (*CreateNewNFTWalletResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_new_wallet", struct {
+		WalletType string `json:"wallet_type"`
+		*CreateNewNFTWalletOptions
+	}{
+		WalletType:                "nft_wallet",
+		CreateNewNFTWalletOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateNewNFTWalletResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTMintNFTOptions options for nft_mint_nft
+type NFTMintNFTOptions struct {
+	WalletID          uint32        `json:"wallet_id"`
+	RoyaltyAddress    types.Address `json:"royalty_address,omitempty"`
+	TargetAddress     types.Address `json:"target_address,omitempty"`
+	URIs              []string      `json:"uris"`
+	MetaURIs          []string      `json:"meta_uris,omitempty"`
+	LicenseURIs       []string      `json:"license_uris,omitempty"`
+	Hash              string        `json:"hash"`
+	MetaHash          string        `json:"meta_hash,omitempty"`
+	LicenseHash       string        `json:"license_hash,omitempty"`
+	EditionNumber     uint64        `json:"edition_number,omitempty"`
+	EditionTotal      uint64        `json:"edition_total,omitempty"`
+	RoyaltyPercentage uint16        `json:"royalty_percentage,omitempty"` // In basis points, 100 = 1%
+	DIDID             string        `json:"did_id,omitempty"`
+	Fee               types.Mojo    `json:"fee"`
+}
+
+// NFTMintNFTResponse response from nft_mint_nft
+type NFTMintNFTResponse struct {
+	rpcinterface.Response
+	WalletID    uint32             `json:"wallet_id"`
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+	NFTID       string             `json:"nft_id"`
+}
+
+// NFTMintNFT wallet rpc -> nft_mint_nft
+func (s *WalletService) NFTMintNFT(opts *NFTMintNFTOptions) (*NFTMintNFTResponse, *http.Response, error) {
+	return s.NFTMintNFTWithContext(context.Background(), opts)
+}
+
+// NFTMintNFTWithContext is the same as NFTMintNFT, but the request is bound to ctx
+func (s *WalletService) NFTMintNFTWithContext(ctx context.Context, opts *NFTMintNFTOptions) (*NFTMintNFTResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_mint_nft", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTMintNFTResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTMintBulkOptions options for nft_mint_bulk
+type NFTMintBulkOptions struct {
+	WalletID          uint32                   `json:"wallet_id"`
+	MetadataList      []*types.NFTMintMetadata `json:"metadata_list"`
+	RoyaltyAddress    types.Address            `json:"royalty_address,omitempty"`
+	RoyaltyPercentage uint16                   `json:"royalty_percentage,omitempty"` // In basis points, 100 = 1%
+	TargetList        []types.Address          `json:"target_list,omitempty"`
+	MintNumberStart   uint64                   `json:"mint_number_start,omitempty"`
+	MintTotal         uint64                   `json:"mint_total,omitempty"`
+	XCHCoins          []*types.Coin            `json:"xch_coins,omitempty"`
+	XCHChangeTarget   types.Address            `json:"xch_change_target,omitempty"`
+	NewInnerpuzhash   string                   `json:"new_innerpuzhash,omitempty"`
+	NewP2Puzhash      string                   `json:"new_p2_puzhash,omitempty"`
+	DIDCoin           *types.Coin              `json:"did_coin,omitempty"`
+	DIDLineageParent  string                   `json:"did_lineage_parent,omitempty"`
+	MintFromDID       bool                     `json:"mint_from_did"`
+	Fee               types.Mojo               `json:"fee"`
+}
+
+// NFTMintBulkResponse response from nft_mint_bulk
+type NFTMintBulkResponse struct {
+	rpcinterface.Response
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+	NFTIDList   []string           `json:"nft_id_list"`
+}
+
+// NFTMintBulk wallet rpc -> nft_mint_bulk
+func (s *WalletService) NFTMintBulk(opts *NFTMintBulkOptions) (*NFTMintBulkResponse, *http.Response, error) {
+	return s.NFTMintBulkWithContext(context.Background(), opts)
+}
+
+// NFTMintBulkWithContext is the same as NFTMintBulk, but the request is bound to ctx
+func (s *WalletService) NFTMintBulkWithContext(ctx context.Context, opts *NFTMintBulkOptions) (*NFTMintBulkResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_mint_bulk", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTMintBulkResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTGetNFTsOptions options for nft_get_nfts
+type NFTGetNFTsOptions struct {
+	WalletID   uint32 `json:"wallet_id"`
+	StartIndex uint32 `json:"start_index"`
+	Num        uint32 `json:"num,omitempty"`
+}
+
+// NFTGetNFTsResponse response from nft_get_nfts
+type NFTGetNFTsResponse struct {
+	rpcinterface.Response
+	WalletID uint32           `json:"wallet_id"`
+	NFTList  []*types.NFTInfo `json:"nft_list"`
+}
+
+// NFTGetNFTs wallet rpc -> nft_get_nfts
+func (s *WalletService) NFTGetNFTs(opts *NFTGetNFTsOptions) (*NFTGetNFTsResponse, *http.Response, error) {
+	return s.NFTGetNFTsWithContext(context.Background(), opts)
+}
+
+// NFTGetNFTsWithContext is the same as NFTGetNFTs, but the request is bound to ctx
+func (s *WalletService) NFTGetNFTsWithContext(ctx context.Context, opts *NFTGetNFTsOptions) (*NFTGetNFTsResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_get_nfts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTGetNFTsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTGetInfoOptions options for nft_get_info
+// CoinID may be the NFT ID (nft1...) or the launcher ID
+// Latest defaults to true when not set, looking up the latest state of the NFT on chain
+type NFTGetInfoOptions struct {
+	CoinID string `json:"coin_id"`
+	Latest *bool  `json:"latest,omitempty"`
+}
+
+// NFTGetInfoResponse response from nft_get_info
+type NFTGetInfoResponse struct {
+	rpcinterface.Response
+	NFTInfo *types.NFTInfo `json:"nft_info"`
+}
+
+// NFTGetInfo wallet rpc -> nft_get_info
+func (s *WalletService) NFTGetInfo(opts *NFTGetInfoOptions) (*NFTGetInfoResponse, *http.Response, error) {
+	return s.NFTGetInfoWithContext(context.Background(), opts)
+}
+
+// NFTGetInfoWithContext is the same as NFTGetInfo, but the request is bound to ctx
+func (s *WalletService) NFTGetInfoWithContext(ctx context.Context, opts *NFTGetInfoOptions) (*NFTGetInfoResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_get_info", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTGetInfoResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTTransferNFTOptions options for nft_transfer_nft
+type NFTTransferNFTOptions struct {
+	WalletID      uint32        `json:"wallet_id"`
+	TargetAddress types.Address `json:"target_address"`
+	NFTCoinID     string        `json:"nft_coin_id"`
+	Fee           types.Mojo    `json:"fee"`
+}
+
+// NFTSpendResponse response from NFT calls that create a spend
+// nft_transfer_nft, nft_set_nft_did and nft_add_uri
+type NFTSpendResponse struct {
+	rpcinterface.Response
+	WalletID    uint32             `json:"wallet_id"`
+	SpendBundle *types.SpendBundle `json:"spend_bundle"`
+}
+
+// NFTTransferNFT wallet rpc -> nft_transfer_nft
+func (s *WalletService) NFTTransferNFT(opts *NFTTransferNFTOptions) (*NFTSpendResponse, *http.Response, error) {
+	return s.NFTTransferNFTWithContext(context.Background(), opts)
+}
+
+// NFTTransferNFTWithContext is the same as NFTTransferNFT, but the request is bound to ctx
+func (s *WalletService) NFTTransferNFTWithContext(ctx context.Context, opts *NFTTransferNFTOptions) (*NFTSpendResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_transfer_nft", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTSpendResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTSetNFTDIDOptions options for nft_set_nft_did
+type NFTSetNFTDIDOptions struct {
+	WalletID  uint32     `json:"wallet_id"`
+	DIDID     string     `json:"did_id"`
+	NFTCoinID string     `json:"nft_coin_id"`
+	Fee       types.Mojo `json:"fee"`
+}
+
+// NFTSetNFTDID wallet rpc -> nft_set_nft_did
+func (s *WalletService) NFTSetNFTDID(opts *NFTSetNFTDIDOptions) (*NFTSpendResponse, *http.Response, error) {
+	return s.NFTSetNFTDIDWithContext(context.Background(), opts)
+}
+
+// NFTSetNFTDIDWithContext is the same as NFTSetNFTDID, but the request is bound to ctx
+func (s *WalletService) NFTSetNFTDIDWithContext(ctx context.Context, opts *NFTSetNFTDIDOptions) (*NFTSpendResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_set_nft_did", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTSpendResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTURIKey which list of URIs to add a URI to
+type NFTURIKey string
+
+const (
+	// NFTURIKeyData data URIs
+	NFTURIKeyData NFTURIKey = "u"
+
+	// NFTURIKeyMetadata metadata URIs
+	NFTURIKeyMetadata NFTURIKey = "mu"
+
+	// NFTURIKeyLicense license URIs
+	NFTURIKeyLicense NFTURIKey = "lu"
+)
+
+// NFTAddURIOptions options for nft_add_uri
+type NFTAddURIOptions struct {
+	WalletID  uint32     `json:"wallet_id"`
+	URI       string     `json:"uri"`
+	Key       NFTURIKey  `json:"key"`
+	NFTCoinID string     `json:"nft_coin_id"`
+	Fee       types.Mojo `json:"fee"`
+}
+
+// NFTAddURI wallet rpc -> nft_add_uri
+func (s *WalletService) NFTAddURI(opts *NFTAddURIOptions) (*NFTSpendResponse, *http.Response, error) {
+	return s.NFTAddURIWithContext(context.Background(), opts)
+}
+
+// NFTAddURIWithContext is the same as NFTAddURI, but the request is bound to ctx
+func (s *WalletService) NFTAddURIWithContext(ctx context.Context, opts *NFTAddURIOptions) (*NFTSpendResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_add_uri", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTSpendResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTGetByDIDOptions options for nft_get_by_did
+type NFTGetByDIDOptions struct {
+	DIDID string `json:"did_id,omitempty"`
+}
+
+// NFTGetByDIDResponse response from nft_get_by_did
+type NFTGetByDIDResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// NFTGetByDID wallet rpc -> nft_get_by_did returns the NFT wallet for the DID
+func (s *WalletService) NFTGetByDID(opts *NFTGetByDIDOptions) (*NFTGetByDIDResponse, *http.Response, error) {
+	return s.NFTGetByDIDWithContext(context.Background(), opts)
+}
+
+// NFTGetByDIDWithContext is the same as NFTGetByDID, but the request is bound to ctx
+func (s *WalletService) NFTGetByDIDWithContext(ctx context.Context, opts *NFTGetByDIDOptions) (*NFTGetByDIDResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_get_by_did", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTGetByDIDResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// NFTCountNFTsOptions options for nft_count_nfts
+// Counts NFTs across every NFT wallet when WalletID is not set
+type NFTCountNFTsOptions struct {
+	WalletID *uint32 `json:"wallet_id,omitempty"`
+}
+
+// NFTCountNFTsResponse response from nft_count_nfts
+type NFTCountNFTsResponse struct {
+	rpcinterface.Response
+	WalletID uint32 `json:"wallet_id"`
+	Count    uint32 `json:"count"`
+}
+
+// NFTCountNFTs wallet rpc -> nft_count_nfts
+func (s *WalletService) NFTCountNFTs(opts *NFTCountNFTsOptions) (*NFTCountNFTsResponse, *http.Response, error) {
+	return s.NFTCountNFTsWithContext(context.Background(), opts)
+}
+
+// NFTCountNFTsWithContext is the same as NFTCountNFTs, but the request is bound to ctx
+func (s *WalletService) NFTCountNFTsWithContext(ctx context.Context, opts *NFTCountNFTsOptions) (*NFTCountNFTsResponse, *http.Response, error) {
+	request, err := s.NewRequest("nft_count_nfts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &NFTCountNFTsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
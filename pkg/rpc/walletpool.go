@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// PoolWalletInitialTargetState the state a new plot NFT should be created in
+// TargetPuzzleHash, PoolURL and RelativeLockHeight are only needed when the state is farming to a pool
+type PoolWalletInitialTargetState struct {
+	State              types.PoolSingletonState `json:"state"`
+	TargetPuzzleHash   types.PuzzleHash         `json:"target_puzzle_hash,omitempty"`
+	PoolURL            string                   `json:"pool_url,omitempty"`
+	RelativeLockHeight uint32                   `json:"relative_lock_height"`
+}
+
+// CreateNewPoolWalletOptions options for creating a plot NFT with create_new_wallet
+type CreateNewPoolWalletOptions struct {
+	InitialTargetState   *PoolWalletInitialTargetState `json:"initial_target_state"`
+	Fee                  types.Mojo                    `json:"fee"`
+	P2SingletonDelayedPH types.PuzzleHash              `json:"p2_singleton_delayed_ph,omitempty"`
+	P2SingletonDelayTime uint64                        `json:"p2_singleton_delay_time,omitempty"`
+}
+
+// CreateNewPoolWalletResponse response from create_new_wallet for a plot NFT
+// The pool wallet is created once the transaction is confirmed
+type CreateNewPoolWalletResponse struct {
+	rpcinterface.Response
+	TotalFee              types.Mojo               `json:"total_fee"`
+	Transaction           *types.TransactionRecord `json:"transaction"`
+	LauncherID            string                   `json:"launcher_id"`
+	P2SingletonPuzzleHash types.PuzzleHash         `json:"p2_singleton_puzzle_hash"`
+}
+
+// CreateNewPoolWallet wallet rpc -> create_new_wallet creates a new plot NFT
+func (s *WalletService) CreateNewPoolWallet(opts *CreateNewPoolWalletOptions) (*CreateNewPoolWalletResponse, *http.Response, error) {
+	return s.CreateNewPoolWalletWithContext(context.Background(), opts)
+}
+
+// CreateNewPoolWalletWithContext is the same as CreateNewPoolWallet, but the request is bound to ctx
+func (s *WalletService) CreateNewPoolWalletWithContext(ctx context.Context, opts *CreateNewPoolWalletOptions) (*CreateNewPoolWalletResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_new_wallet", struct {
+		WalletType string `json:"wallet_type"`
+		Mode       string `json:"mode"`
+		*CreateNewPoolWalletOptions
+	}{
+		WalletType:                 "pool_wallet",
+		Mode:                       "new",
+		CreateNewPoolWalletOptions: opts,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateNewPoolWalletResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PWJoinPoolOptions options for pw_join_pool
+type PWJoinPoolOptions struct {
+	WalletID           uint32           `json:"wallet_id"`
+	TargetPuzzleHash   types.PuzzleHash `json:"target_puzzlehash"`
+	PoolURL            string           `json:"pool_url"`
+	RelativeLockHeight uint32           `json:"relative_lock_height"`
+	Fee                types.Mojo       `json:"fee"`
+}
+
+// PWTransitionResponse response from pw_join_pool and pw_self_pool
+type PWTransitionResponse struct {
+	rpcinterface.Response
+	TotalFee       types.Mojo               `json:"total_fee"`
+	Transaction    *types.TransactionRecord `json:"transaction"`
+	FeeTransaction *types.TransactionRecord `json:"fee_transaction"`
+}
+
+// PWJoinPool wallet rpc -> pw_join_pool
+func (s *WalletService) PWJoinPool(opts *PWJoinPoolOptions) (*PWTransitionResponse, *http.Response, error) {
+	return s.PWJoinPoolWithContext(context.Background(), opts)
+}
+
+// PWJoinPoolWithContext is the same as PWJoinPool, but the request is bound to ctx
+func (s *WalletService) PWJoinPoolWithContext(ctx context.Context, opts *PWJoinPoolOptions) (*PWTransitionResponse, *http.Response, error) {
+	request, err := s.NewRequest("pw_join_pool", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PWTransitionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PWSelfPoolOptions options for pw_self_pool
+type PWSelfPoolOptions struct {
+	WalletID uint32     `json:"wallet_id"`
+	Fee      types.Mojo `json:"fee"`
+}
+
+// PWSelfPool wallet rpc -> pw_self_pool
+func (s *WalletService) PWSelfPool(opts *PWSelfPoolOptions) (*PWTransitionResponse, *http.Response, error) {
+	return s.PWSelfPoolWithContext(context.Background(), opts)
+}
+
+// PWSelfPoolWithContext is the same as PWSelfPool, but the request is bound to ctx
+func (s *WalletService) PWSelfPoolWithContext(ctx context.Context, opts *PWSelfPoolOptions) (*PWTransitionResponse, *http.Response, error) {
+	request, err := s.NewRequest("pw_self_pool", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PWTransitionResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PWAbsorbRewardsOptions options for pw_absorb_rewards
+type PWAbsorbRewardsOptions struct {
+	WalletID      uint32     `json:"wallet_id"`
+	Fee           types.Mojo `json:"fee"`
+	MaxSpendsInTX uint32     `json:"max_spends_in_tx,omitempty"`
+}
+
+// PWAbsorbRewardsResponse response from pw_absorb_rewards
+type PWAbsorbRewardsResponse struct {
+	rpcinterface.Response
+	State          *types.PoolWalletInfo    `json:"state"`
+	Transaction    *types.TransactionRecord `json:"transaction"`
+	FeeTransaction *types.TransactionRecord `json:"fee_transaction"`
+}
+
+// PWAbsorbRewards wallet rpc -> pw_absorb_rewards claims self pooling rewards from the p2 singleton
+func (s *WalletService) PWAbsorbRewards(opts *PWAbsorbRewardsOptions) (*PWAbsorbRewardsResponse, *http.Response, error) {
+	return s.PWAbsorbRewardsWithContext(context.Background(), opts)
+}
+
+// PWAbsorbRewardsWithContext is the same as PWAbsorbRewards, but the request is bound to ctx
+func (s *WalletService) PWAbsorbRewardsWithContext(ctx context.Context, opts *PWAbsorbRewardsOptions) (*PWAbsorbRewardsResponse, *http.Response, error) {
+	request, err := s.NewRequest("pw_absorb_rewards", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PWAbsorbRewardsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// PWStatusOptions options for pw_status
+type PWStatusOptions struct {
+	WalletID uint32 `json:"wallet_id"`
+}
+
+// PWStatusResponse response from pw_status
+type PWStatusResponse struct {
+	rpcinterface.Response
+	State                   *types.PoolWalletInfo      `json:"state"`
+	UnconfirmedTransactions []*types.TransactionRecord `json:"unconfirmed_transactions"`
+}
+
+// PWStatus wallet rpc -> pw_status
+func (s *WalletService) PWStatus(opts *PWStatusOptions) (*PWStatusResponse, *http.Response, error) {
+	return s.PWStatusWithContext(context.Background(), opts)
+}
+
+// PWStatusWithContext is the same as PWStatus, but the request is bound to ctx
+func (s *WalletService) PWStatusWithContext(ctx context.Context, opts *PWStatusOptions) (*PWStatusResponse, *http.Response, error) {
+	request, err := s.NewRequest("pw_status", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &PWStatusResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
@@ -0,0 +1,325 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// CreateOfferForIDsOptions options for create_offer_for_ids
+type CreateOfferForIDsOptions struct {
+	// Offer maps wallet ID to amount. Negative amounts are offered, positive amounts are requested
+	Offer         map[uint32]int64       `json:"offer"`
+	Fee           types.Mojo             `json:"fee"`
+	ValidateOnly  bool                   `json:"validate_only"`
+	DriverDict    map[string]interface{} `json:"driver_dict,omitempty"`
+	MinCoinAmount types.Mojo             `json:"min_coin_amount,omitempty"`
+}
+
+// CreateOfferForIDsResponse response from create_offer_for_ids
+type CreateOfferForIDsResponse struct {
+	rpcinterface.Response
+	Offer       string             `json:"offer"`
+	TradeRecord *types.TradeRecord `json:"trade_record"`
+}
+
+// CreateOfferForIDs wallet rpc -> create_offer_for_ids
+func (s *WalletService) CreateOfferForIDs(opts *CreateOfferForIDsOptions) (*CreateOfferForIDsResponse, *http.Response, error) {
+	return s.CreateOfferForIDsWithContext(context.Background(), opts)
+}
+
+// CreateOfferForIDsWithContext is the same as CreateOfferForIDs, but the request is bound to ctx
+func (s *WalletService) CreateOfferForIDsWithContext(ctx context.Context, opts *CreateOfferForIDsOptions) (*CreateOfferForIDsResponse, *http.Response, error) {
+	request, err := s.NewRequest("create_offer_for_ids", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CreateOfferForIDsResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// OfferOptions options for calls that operate on an offer file
+// get_offer_summary and check_offer_validity
+type OfferOptions struct {
+	Offer string `json:"offer"`
+}
+
+// GetOfferSummaryResponse response from get_offer_summary
+type GetOfferSummaryResponse struct {
+	rpcinterface.Response
+	Summary *types.OfferSummary `json:"summary"`
+}
+
+// GetOfferSummary wallet rpc -> get_offer_summary
+func (s *WalletService) GetOfferSummary(opts *OfferOptions) (*GetOfferSummaryResponse, *http.Response, error) {
+	return s.GetOfferSummaryWithContext(context.Background(), opts)
+}
+
+// GetOfferSummaryWithContext is the same as GetOfferSummary, but the request is bound to ctx
+func (s *WalletService) GetOfferSummaryWithContext(ctx context.Context, opts *OfferOptions) (*GetOfferSummaryResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_offer_summary", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetOfferSummaryResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CheckOfferValidityResponse response from check_offer_validity
+type CheckOfferValidityResponse struct {
+	rpcinterface.Response
+	Valid bool   `json:"valid"`
+	ID    string `json:"id"`
+}
+
+// CheckOfferValidity wallet rpc -> check_offer_validity
+func (s *WalletService) CheckOfferValidity(opts *OfferOptions) (*CheckOfferValidityResponse, *http.Response, error) {
+	return s.CheckOfferValidityWithContext(context.Background(), opts)
+}
+
+// CheckOfferValidityWithContext is the same as CheckOfferValidity, but the request is bound to ctx
+func (s *WalletService) CheckOfferValidityWithContext(ctx context.Context, opts *OfferOptions) (*CheckOfferValidityResponse, *http.Response, error) {
+	request, err := s.NewRequest("check_offer_validity", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &CheckOfferValidityResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// TakeOfferOptions options for take_offer
+type TakeOfferOptions struct {
+	Offer         string     `json:"offer"`
+	Fee           types.Mojo `json:"fee"`
+	MinCoinAmount types.Mojo `json:"min_coin_amount,omitempty"`
+}
+
+// TradeRecordResponse response from calls that return a single trade record
+type TradeRecordResponse struct {
+	rpcinterface.Response
+	TradeRecord *types.TradeRecord `json:"trade_record"`
+}
+
+// TakeOffer wallet rpc -> take_offer
+func (s *WalletService) TakeOffer(opts *TakeOfferOptions) (*TradeRecordResponse, *http.Response, error) {
+	return s.TakeOfferWithContext(context.Background(), opts)
+}
+
+// TakeOfferWithContext is the same as TakeOffer, but the request is bound to ctx
+func (s *WalletService) TakeOfferWithContext(ctx context.Context, opts *TakeOfferOptions) (*TradeRecordResponse, *http.Response, error) {
+	request, err := s.NewRequest("take_offer", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &TradeRecordResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetOfferOptions options for get_offer
+type GetOfferOptions struct {
+	TradeID string `json:"trade_id"`
+	// FileContents includes the offer file in the response
+	FileContents bool `json:"file_contents"`
+}
+
+// GetOfferResponse response from get_offer
+// Offer is only set when FileContents was requested
+type GetOfferResponse struct {
+	rpcinterface.Response
+	TradeRecord *types.TradeRecord `json:"trade_record"`
+	Offer       *string            `json:"offer"`
+}
+
+// GetOffer wallet rpc -> get_offer
+func (s *WalletService) GetOffer(opts *GetOfferOptions) (*GetOfferResponse, *http.Response, error) {
+	return s.GetOfferWithContext(context.Background(), opts)
+}
+
+// GetOfferWithContext is the same as GetOffer, but the request is bound to ctx
+func (s *WalletService) GetOfferWithContext(ctx context.Context, opts *GetOfferOptions) (*GetOfferResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_offer", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetOfferResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetOfferForTransaction returns the trade record for a transaction that is part of a trade
+func (s *WalletService) GetOfferForTransaction(transaction *types.TransactionRecord) (*GetOfferResponse, *http.Response, error) {
+	return s.GetOfferForTransactionWithContext(context.Background(), transaction)
+}
+
+// GetOfferForTransactionWithContext is the same as GetOfferForTransaction, but the request is bound to ctx
+func (s *WalletService) GetOfferForTransactionWithContext(ctx context.Context, transaction *types.TransactionRecord) (*GetOfferResponse, *http.Response, error) {
+	if !transaction.IsTrade() || transaction.TradeID == "" {
+		return nil, nil, fmt.Errorf("transaction %s is not part of a trade", transaction.Name)
+	}
+
+	return s.GetOfferWithContext(ctx, &GetOfferOptions{TradeID: transaction.TradeID})
+}
+
+// GetAllOffersOptions options for get_all_offers
+// End defaults to 10 when not set
+type GetAllOffersOptions struct {
+	Start              uint32 `json:"start"`
+	End                uint32 `json:"end,omitempty"`
+	ExcludeMyOffers    bool   `json:"exclude_my_offers"`
+	ExcludeTakenOffers bool   `json:"exclude_taken_offers"`
+	IncludeCompleted   bool   `json:"include_completed"`
+	SortKey            string `json:"sort_key,omitempty"`
+	Reverse            bool   `json:"reverse"`
+	// FileContents includes the offer files in the response
+	FileContents bool `json:"file_contents"`
+}
+
+// GetAllOffersResponse response from get_all_offers
+// Offers is only set when FileContents was requested, and is in the same order as TradeRecords
+type GetAllOffersResponse struct {
+	rpcinterface.Response
+	TradeRecords []*types.TradeRecord `json:"trade_records"`
+	Offers       []string             `json:"offers"`
+}
+
+// GetAllOffers wallet rpc -> get_all_offers
+func (s *WalletService) GetAllOffers(opts *GetAllOffersOptions) (*GetAllOffersResponse, *http.Response, error) {
+	return s.GetAllOffersWithContext(context.Background(), opts)
+}
+
+// GetAllOffersWithContext is the same as GetAllOffers, but the request is bound to ctx
+func (s *WalletService) GetAllOffersWithContext(ctx context.Context, opts *GetAllOffersOptions) (*GetAllOffersResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_all_offers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetAllOffersResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetOffersCountResponse response from get_offers_count
+type GetOffersCountResponse struct {
+	rpcinterface.Response
+	Total            uint32 `json:"total"`
+	MyOffersCount    uint32 `json:"my_offers_count"`
+	TakenOffersCount uint32 `json:"taken_offers_count"`
+}
+
+// GetOffersCount wallet rpc -> get_offers_count
+func (s *WalletService) GetOffersCount() (*GetOffersCountResponse, *http.Response, error) {
+	return s.GetOffersCountWithContext(context.Background())
+}
+
+// GetOffersCountWithContext is the same as GetOffersCount, but the request is bound to ctx
+func (s *WalletService) GetOffersCountWithContext(ctx context.Context) (*GetOffersCountResponse, *http.Response, error) {
+	request, err := s.NewRequest("get_offers_count", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &GetOffersCountResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CancelOfferOptions options for cancel_offer
+// Secure cancels the offer on chain by spending the coins, otherwise the offer is only forgotten by the wallet
+type CancelOfferOptions struct {
+	TradeID string     `json:"trade_id"`
+	Secure  bool       `json:"secure"`
+	Fee     types.Mojo `json:"fee"`
+}
+
+// CancelOffer wallet rpc -> cancel_offer
+func (s *WalletService) CancelOffer(opts *CancelOfferOptions) (*EmptyResponse, *http.Response, error) {
+	return s.CancelOfferWithContext(context.Background(), opts)
+}
+
+// CancelOfferWithContext is the same as CancelOffer, but the request is bound to ctx
+func (s *WalletService) CancelOfferWithContext(ctx context.Context, opts *CancelOfferOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("cancel_offer", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CancelOffersOptions options for cancel_offers
+// Set CancelAll to cancel every offer, or AssetID to cancel all offers for the asset ("xch" for XCH)
+type CancelOffersOptions struct {
+	Secure    bool       `json:"secure"`
+	BatchFee  types.Mojo `json:"batch_fee"`
+	BatchSize uint32     `json:"batch_size,omitempty"`
+	CancelAll bool       `json:"cancel_all"`
+	AssetID   string     `json:"asset_id,omitempty"`
+}
+
+// CancelOffers wallet rpc -> cancel_offers
+func (s *WalletService) CancelOffers(opts *CancelOffersOptions) (*EmptyResponse, *http.Response, error) {
+	return s.CancelOffersWithContext(context.Background(), opts)
+}
+
+// CancelOffersWithContext is the same as CancelOffers, but the request is bound to ctx
+func (s *WalletService) CancelOffersWithContext(ctx context.Context, opts *CancelOffersOptions) (*EmptyResponse, *http.Response, error) {
+	request, err := s.NewRequest("cancel_offers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &EmptyResponse{}
+	resp, err := s.DoWithContext(ctx, request, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
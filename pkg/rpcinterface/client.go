@@ -1,18 +1,41 @@
 package rpcinterface
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/config"
 )
 
 // Client defines the interface for a client
 // HTTP (standard RPC) and websockets are the two supported now
+// Methods are added to this interface as the clients gain features, which breaks implementations outside this module
+// See "Implementing rpcinterface.Client" in the readme for what each addition requires
 type Client interface {
 	NewRequest(service ServiceType, rpcEndpoint Endpoint, opt interface{}) (*Request, error)
 	Do(req *Request, v interface{}) (*http.Response, error) // @TODO probably need wrapped/generic response? Not back compat though
+	// DoWithContext is the same as Do, but the request is aborted if the context is cancelled or its deadline passes
+	DoWithContext(ctx context.Context, req *Request, v interface{}) (*http.Response, error)
 	SetBaseURL(url *url.URL) error
 	SetCacheValidTime(validTime time.Duration)
+	// SetTimeout sets the maximum time a single request may take. Zero means no limit outside the request context
+	SetTimeout(timeout time.Duration)
+	// SetCAPool verifies the server certificate was issued by one of the CAs in the pool
+	SetCAPool(pool *x509.CertPool)
+	// SetConfig sets the chia config that ports and key pairs are read from, when not set for the service directly
+	SetConfig(cfg *config.ChiaConfig, rootPath string)
+	// SetServicePort sets the port for the service, instead of reading it from the chia config
+	SetServicePort(service ServiceType, port uint16)
+	// SetServiceKeyPair sets the key pair for the service, instead of loading it from the paths in the chia config
+	SetServiceKeyPair(service ServiceType, keyPair *tls.Certificate)
+	// AddServerFingerprint pins the server certificate to a SHA-256 fingerprint. May be called more than once
+	AddServerFingerprint(fingerprint []byte)
+	// Close releases the client's connections and stops any background goroutines
+	Close(ctx context.Context) error
 
 	// The following are added for websocket compatibility
 	// Any implementation that these don't make sense for should just do nothing / return nil as applicable
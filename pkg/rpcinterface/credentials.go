@@ -0,0 +1,186 @@
+package rpcinterface
+
+import (
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/config"
+)
+
+// The chia config does not have a data_layer section in all versions, so these are the defaults from chia-blockchain
+const (
+	defaultDataLayerRPCPort uint16 = 8562
+	defaultDataLayerCRT            = "config/ssl/data_layer/private_data_layer.crt"
+	defaultDataLayerKey            = "config/ssl/data_layer/private_data_layer.key"
+)
+
+// ServiceCredentials provides the port and key pair for each service
+// Explicitly set ports and key pairs are used first. Anything else is read from the chia config, which is only loaded
+// the first time it is needed, so a client only needs credentials for the services it actually uses
+type ServiceCredentials struct {
+	// config is loaded from CHIA_ROOT when needed, if not set
+	config *config.ChiaConfig
+
+	// rootPath is the chia root that SSL paths in the config are relative to. Defaults to CHIA_ROOT
+	rootPath string
+
+	ports    map[ServiceType]uint16
+	keyPairs map[ServiceType]*tls.Certificate
+
+	lock sync.Mutex
+}
+
+// SetConfig sets the chia config to read ports and key pairs from
+// Relative SSL paths in the config are relative to rootPath, or CHIA_ROOT if rootPath is empty
+func (s *ServiceCredentials) SetConfig(cfg *config.ChiaConfig, rootPath string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.config = cfg
+	s.rootPath = rootPath
+}
+
+// SetPort sets the port for the service, instead of reading it from the chia config
+func (s *ServiceCredentials) SetPort(service ServiceType, port uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ports == nil {
+		s.ports = map[ServiceType]uint16{}
+	}
+	s.ports[service] = port
+}
+
+// SetKeyPair sets the key pair for the service, instead of loading it from the paths in the chia config
+func (s *ServiceCredentials) SetKeyPair(service ServiceType, keyPair *tls.Certificate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.keyPairs == nil {
+		s.keyPairs = map[ServiceType]*tls.Certificate{}
+	}
+	s.keyPairs[service] = keyPair
+}
+
+// Port returns the port for the service
+func (s *ServiceCredentials) Port(service ServiceType) (uint16, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if port, ok := s.ports[service]; ok {
+		return port, nil
+	}
+	if service == ServiceDataLayer {
+		return defaultDataLayerRPCPort, nil
+	}
+
+	cfg, err := s.loadConfig()
+	if err != nil {
+		return 0, fmt.Errorf("no port set for %s, and unable to load chia config: %w", service, err)
+	}
+
+	switch service {
+	case ServiceDaemon:
+		return cfg.DaemonPort, nil
+	case ServiceFullNode:
+		return cfg.FullNode.RPCPort, nil
+	case ServiceFarmer:
+		return cfg.Farmer.RPCPort, nil
+	case ServiceHarvester:
+		return cfg.Harvester.RPCPort, nil
+	case ServiceWallet:
+		return cfg.Wallet.RPCPort, nil
+	case ServiceCrawler:
+		return cfg.Seeder.CrawlerConfig.RPCPort, nil
+	}
+
+	return 0, fmt.Errorf("unknown service")
+}
+
+// KeyPair returns the key pair for the service, loading it the first time it is requested
+func (s *ServiceCredentials) KeyPair(service ServiceType) (*tls.Certificate, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if keyPair, ok := s.keyPairs[service]; ok {
+		return keyPair, nil
+	}
+
+	var ssl config.SSLConfig
+	if service == ServiceDataLayer {
+		ssl = config.SSLConfig{PrivateCRT: defaultDataLayerCRT, PrivateKey: defaultDataLayerKey}
+	} else {
+		cfg, err := s.loadConfig()
+		if err != nil {
+			return nil, fmt.Errorf("no key pair set for %s, and unable to load chia config: %w", service, err)
+		}
+
+		switch service {
+		case ServiceDaemon:
+			ssl = cfg.DaemonSSL
+		case ServiceFullNode:
+			ssl = cfg.FullNode.SSL
+		case ServiceFarmer:
+			ssl = cfg.Farmer.SSL
+		case ServiceHarvester:
+			ssl = cfg.Harvester.SSL
+		case ServiceWallet:
+			ssl = cfg.Wallet.SSL
+		case ServiceCrawler:
+			ssl = cfg.Seeder.CrawlerConfig.SSL
+		default:
+			return nil, fmt.Errorf("unknown service")
+		}
+	}
+
+	rootPath, err := s.root()
+	if err != nil {
+		return nil, err
+	}
+	pair, err := tls.LoadX509KeyPair(resolvePath(rootPath, ssl.PrivateCRT), resolvePath(rootPath, ssl.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load key pair for %s: %w", service, err)
+	}
+
+	if s.keyPairs == nil {
+		s.keyPairs = map[ServiceType]*tls.Certificate{}
+	}
+	s.keyPairs[service] = &pair
+
+	return &pair, nil
+}
+
+// loadConfig returns the chia config, loading it from CHIA_ROOT if it hasn't been set
+// Must be called with the lock held
+func (s *ServiceCredentials) loadConfig() (*config.ChiaConfig, error) {
+	if s.config == nil {
+		cfg, err := config.GetChiaConfig()
+		if err != nil {
+			return nil, err
+		}
+		s.config = cfg
+	}
+
+	return s.config, nil
+}
+
+// root returns the chia root that relative SSL paths are relative to
+// Must be called with the lock held
+func (s *ServiceCredentials) root() (string, error) {
+	if s.rootPath != "" {
+		return s.rootPath, nil
+	}
+
+	return config.GetChiaRootPath()
+}
+
+// resolvePath returns p if it is absolute, or p relative to rootPath
+func resolvePath(rootPath string, p string) string {
+	if filepath.IsAbs(p) {
+		return p
+	}
+
+	return filepath.Join(rootPath, p)
+}
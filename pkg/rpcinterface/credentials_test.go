@@ -0,0 +1,132 @@
+package rpcinterface_test
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/config"
+
+	"github.com/cmmarslender/go-chia-rpc/internal/testutil"
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+)
+
+// withEmptyChiaRoot points CHIA_ROOT at an empty directory for the rest of the test, so loading the config fails
+func withEmptyChiaRoot(t *testing.T) {
+	oldRoot, hadRoot := os.LookupEnv("CHIA_ROOT")
+	err := os.Setenv("CHIA_ROOT", t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if hadRoot {
+			_ = os.Setenv("CHIA_ROOT", oldRoot)
+		} else {
+			_ = os.Unsetenv("CHIA_ROOT")
+		}
+	})
+}
+
+func TestServiceCredentialsLoadsOnlyUsedServices(t *testing.T) {
+	root := t.TempDir()
+	testutil.WriteKeyPair(t, filepath.Join(root, "config/ssl/wallet/private_wallet.crt"), filepath.Join(root, "config/ssl/wallet/private_wallet.key"))
+
+	cfg := &config.ChiaConfig{}
+	cfg.Wallet.RPCPort = 9256
+	cfg.Wallet.SSL = config.SSLConfig{PrivateCRT: "config/ssl/wallet/private_wallet.crt", PrivateKey: "config/ssl/wallet/private_wallet.key"}
+	cfg.Seeder.CrawlerConfig.SSL = config.SSLConfig{PrivateCRT: "config/ssl/crawler/private_crawler.crt", PrivateKey: "config/ssl/crawler/private_crawler.key"}
+
+	var credentials rpcinterface.ServiceCredentials
+	credentials.SetConfig(cfg, root)
+
+	port, err := credentials.Port(rpcinterface.ServiceWallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 9256 {
+		t.Errorf("expected wallet port 9256, got %d", port)
+	}
+
+	keyPair, err := credentials.KeyPair(rpcinterface.ServiceWallet)
+	if err != nil {
+		t.Fatalf("expected the wallet key pair to load without crawler certs, got %v", err)
+	}
+	cached, err := credentials.KeyPair(rpcinterface.ServiceWallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached != keyPair {
+		t.Error("expected the wallet key pair to be loaded once and reused")
+	}
+
+	_, err = credentials.KeyPair(rpcinterface.ServiceCrawler)
+	if err == nil {
+		t.Error("expected an error loading the missing crawler key pair")
+	}
+}
+
+func TestServiceCredentialsAbsolutePaths(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "node.crt")
+	keyPath := filepath.Join(dir, "node.key")
+	testutil.WriteKeyPair(t, certPath, keyPath)
+
+	cfg := &config.ChiaConfig{}
+	cfg.FullNode.SSL = config.SSLConfig{PrivateCRT: certPath, PrivateKey: keyPath}
+
+	var credentials rpcinterface.ServiceCredentials
+	credentials.SetConfig(cfg, t.TempDir())
+
+	_, err := credentials.KeyPair(rpcinterface.ServiceFullNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServiceCredentialsWithoutConfig(t *testing.T) {
+	withEmptyChiaRoot(t)
+
+	dir := t.TempDir()
+	testutil.WriteKeyPair(t, filepath.Join(dir, "wallet.crt"), filepath.Join(dir, "wallet.key"))
+	walletKeyPair, err := tls.LoadX509KeyPair(filepath.Join(dir, "wallet.crt"), filepath.Join(dir, "wallet.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var credentials rpcinterface.ServiceCredentials
+	credentials.SetPort(rpcinterface.ServiceWallet, 9256)
+	credentials.SetKeyPair(rpcinterface.ServiceWallet, &walletKeyPair)
+
+	port, err := credentials.Port(rpcinterface.ServiceWallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 9256 {
+		t.Errorf("expected wallet port 9256, got %d", port)
+	}
+	keyPair, err := credentials.KeyPair(rpcinterface.ServiceWallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyPair != &walletKeyPair {
+		t.Error("expected the wallet key pair that was set")
+	}
+
+	port, err = credentials.Port(rpcinterface.ServiceDataLayer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 8562 {
+		t.Errorf("expected the default data layer port 8562, got %d", port)
+	}
+
+	_, err = credentials.Port(rpcinterface.ServiceFullNode)
+	if err == nil {
+		t.Error("expected an error for a port that wasn't set, without a chia config")
+	}
+	_, err = credentials.KeyPair(rpcinterface.ServiceFullNode)
+	if err == nil {
+		t.Error("expected an error for a key pair that wasn't set, without a chia config")
+	}
+}
@@ -0,0 +1,57 @@
+package rpcinterface
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RPCError is returned when the RPC server responds with a non-2xx status or `success: false`
+type RPCError struct {
+	Service    ServiceType
+	Endpoint   Endpoint
+	StatusCode int
+	Message    string
+	Body       []byte
+}
+
+// Error satisfies the error interface
+func (e *RPCError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s -> %s failed: %s", e.Service, e.Endpoint, msg)
+}
+
+// CheckResponse inspects a raw RPC response and returns an *RPCError if the request was not successful
+// statusCode should be 0 when the transport has no concept of status codes (websockets)
+func CheckResponse(service ServiceType, endpoint Endpoint, statusCode int, body []byte) error {
+	rpcErr := &RPCError{
+		Service:    service,
+		Endpoint:   endpoint,
+		StatusCode: statusCode,
+		Body:       body,
+	}
+
+	// Pointer so we can tell the difference between false and missing
+	envelope := &struct {
+		Success *bool  `json:"success"`
+		Error   string `json:"error"`
+	}{}
+	jsonErr := json.Unmarshal(body, envelope)
+	if jsonErr == nil {
+		rpcErr.Message = envelope.Error
+	}
+
+	if statusCode != 0 && (statusCode < 200 || statusCode > 299) {
+		return rpcErr
+	}
+
+	if jsonErr == nil && envelope.Success != nil && !*envelope.Success {
+		return rpcErr
+	}
+
+	return nil
+}
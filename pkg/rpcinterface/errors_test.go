@@ -0,0 +1,56 @@
+package rpcinterface_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+)
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    bool
+		wantMsg    string
+	}{
+		{name: "success", statusCode: http.StatusOK, body: `{"success": true}`},
+		{name: "no envelope", statusCode: http.StatusOK, body: `{"count": 1}`},
+		{name: "websocket success", statusCode: 0, body: `{"success": true}`},
+		{name: "chia error", statusCode: http.StatusOK, body: `{"success": false, "error": "Block not found"}`, wantErr: true, wantMsg: "Block not found"},
+		{name: "websocket chia error", statusCode: 0, body: `{"success": false, "error": "nope"}`, wantErr: true, wantMsg: "nope"},
+		{name: "http status", statusCode: http.StatusInternalServerError, body: `Internal Server Error`, wantErr: true},
+		{name: "http status with chia error", statusCode: http.StatusBadRequest, body: `{"success": false, "error": "bad"}`, wantErr: true, wantMsg: "bad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rpcinterface.CheckResponse(rpcinterface.ServiceFullNode, "get_block", tt.statusCode, []byte(tt.body))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			rpcErr := &rpcinterface.RPCError{}
+			if !errors.As(err, &rpcErr) {
+				t.Fatalf("expected *RPCError, got %T", err)
+			}
+			if rpcErr.Message != tt.wantMsg {
+				t.Errorf("expected message %q, got %q", tt.wantMsg, rpcErr.Message)
+			}
+			if rpcErr.StatusCode != tt.statusCode {
+				t.Errorf("expected status %d, got %d", tt.statusCode, rpcErr.StatusCode)
+			}
+			if rpcErr.Endpoint != "get_block" || rpcErr.Service != rpcinterface.ServiceFullNode {
+				t.Errorf("unexpected endpoint/service %s/%s", rpcErr.Service, rpcErr.Endpoint)
+			}
+			if string(rpcErr.Body) != tt.body {
+				t.Errorf("expected raw body to be preserved")
+			}
+		})
+	}
+}
@@ -8,4 +8,7 @@ type Request struct {
 	Endpoint Endpoint
 	Data     interface{}
 	Request  *http.Request
+
+	// NoCache skips the request cache, for requests that must always reflect the current state
+	NoCache bool
 }
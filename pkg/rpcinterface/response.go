@@ -0,0 +1,8 @@
+package rpcinterface
+
+// Response is the envelope that is common to every RPC response
+// Chia sets Success to false and populates Error when a request fails
+type Response struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
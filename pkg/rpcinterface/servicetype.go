@@ -1,5 +1,7 @@
 package rpcinterface
 
+import "fmt"
+
 // ServiceType is a type that refers to a particular service
 type ServiceType uint8
 
@@ -27,4 +29,33 @@ const (
 
 	// ServiceCrawler crawler service
 	ServiceCrawler
+
+	// ServiceDataLayer DataLayer service
+	ServiceDataLayer
 )
+
+// String returns the name chia uses for the service
+func (s ServiceType) String() string {
+	switch s {
+	case ServiceDaemon:
+		return "daemon"
+	case ServiceFullNode:
+		return "full_node"
+	case ServiceFarmer:
+		return "farmer"
+	case ServiceHarvester:
+		return "harvester"
+	case ServiceWallet:
+		return "wallet"
+	case ServiceTimelord:
+		return "timelord"
+	case ServicePeer:
+		return "peer"
+	case ServiceCrawler:
+		return "crawler"
+	case ServiceDataLayer:
+		return "data_layer"
+	}
+
+	return fmt.Sprintf("unknown(%d)", uint8(s))
+}
@@ -0,0 +1,115 @@
+package rpcinterface
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ChiaServerName is the DNS name chia puts on every certificate it generates, regardless of the host it runs on
+const ChiaServerName = "chia.net"
+
+// TLSVerification configures how a client verifies the server's certificate
+// The zero value does not verify the server, which is only appropriate when connecting to a node on the same host
+type TLSVerification struct {
+	// CAPool verifies that the server certificate was issued by one of these CAs for ChiaServerName
+	// For chia, this is usually the node's config/ssl/ca/private_ca.crt
+	CAPool *x509.CertPool
+
+	// Fingerprints pins the server certificate to one of these SHA-256 fingerprints of the certificate
+	Fingerprints [][]byte
+}
+
+// ParseFingerprint parses a hex SHA-256 certificate fingerprint, with or without colons between bytes
+func ParseFingerprint(fingerprint string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate fingerprint: %w", err)
+	}
+	if len(b) != sha256.Size {
+		return nil, fmt.Errorf("invalid certificate fingerprint: expected %d bytes, got %d", sha256.Size, len(b))
+	}
+
+	return b, nil
+}
+
+// Enabled returns true if the server certificate will be verified
+func (v *TLSVerification) Enabled() bool {
+	return v.CAPool != nil || len(v.Fingerprints) > 0
+}
+
+// TLSConfig returns a TLS config that presents the key pair to the server, if provided, and verifies the server
+// certificate
+func (v *TLSVerification) TLSConfig(keyPair *tls.Certificate) *tls.Config {
+	cfg := &tls.Config{
+		// Go's built in verification checks the certificate against the host being connected to, which chia
+		// certificates never match, so verification is done in VerifyPeerCertificate instead
+		InsecureSkipVerify: true,
+	}
+	if keyPair != nil {
+		cfg.Certificates = []tls.Certificate{*keyPair}
+	}
+	if v.Enabled() {
+		// Handshakes verify against a copy, so changes to v after this don't race with connections using the config
+		verification := &TLSVerification{
+			CAPool:       v.CAPool,
+			Fingerprints: append([][]byte{}, v.Fingerprints...),
+		}
+		cfg.VerifyPeerCertificate = verification.verifyPeerCertificate
+	}
+
+	return cfg
+}
+
+// verifyPeerCertificate checks the certificate chain presented by the server against the CA pool and fingerprints
+func (v *TLSVerification) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("server did not present a certificate")
+	}
+
+	if len(v.Fingerprints) > 0 {
+		fingerprint := sha256.Sum256(rawCerts[0])
+		matched := false
+		for _, pinned := range v.Fingerprints {
+			if bytes.Equal(pinned, fingerprint[:]) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("server certificate fingerprint %x is not pinned", fingerprint)
+		}
+	}
+
+	if v.CAPool != nil {
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("unable to parse server certificate: %w", err)
+			}
+			certs[i] = cert
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			DNSName:       ChiaServerName,
+			Roots:         v.CAPool,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			return fmt.Errorf("unable to verify server certificate: %w", err)
+		}
+	}
+
+	return nil
+}
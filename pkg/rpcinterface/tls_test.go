@@ -0,0 +1,120 @@
+package rpcinterface_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate signed by parent, or a self signed CA if parent is nil
+func newTestCert(t *testing.T, parent *testCert, dnsNames ...string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "Chia"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     dnsNames,
+	}
+
+	signer := &testCert{cert: template, key: key}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer = parent
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, &key.PublicKey, signer.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCert{cert: cert, key: key}
+}
+
+func TestTLSVerification(t *testing.T) {
+	ca := newTestCert(t, nil)
+	otherCA := newTestCert(t, nil)
+	server := newTestCert(t, ca, rpcinterface.ChiaServerName)
+	wrongName := newTestCert(t, ca, "example.com")
+	otherServer := newTestCert(t, otherCA, rpcinterface.ChiaServerName)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	fingerprint := sha256.Sum256(server.cert.Raw)
+
+	tests := []struct {
+		name         string
+		verification rpcinterface.TLSVerification
+		cert         *testCert
+		wantErr      bool
+	}{
+		{name: "issued by ca", verification: rpcinterface.TLSVerification{CAPool: pool}, cert: server},
+		{name: "issued by other ca", verification: rpcinterface.TLSVerification{CAPool: pool}, cert: otherServer, wantErr: true},
+		{name: "not chia.net", verification: rpcinterface.TLSVerification{CAPool: pool}, cert: wrongName, wantErr: true},
+		{name: "pinned", verification: rpcinterface.TLSVerification{Fingerprints: [][]byte{fingerprint[:]}}, cert: server},
+		{name: "not pinned", verification: rpcinterface.TLSVerification{Fingerprints: [][]byte{fingerprint[:]}}, cert: otherServer, wantErr: true},
+		{name: "ca and pinned", verification: rpcinterface.TLSVerification{CAPool: pool, Fingerprints: [][]byte{fingerprint[:]}}, cert: server},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.verification.TLSConfig(nil)
+			err := cfg.VerifyPeerCertificate([][]byte{tt.cert.cert.Raw}, nil)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseFingerprint(t *testing.T) {
+	sum := sha256.Sum256([]byte("chia"))
+	hexFingerprint := fmt.Sprintf("%x", sum)
+
+	var colons []string
+	for i := 0; i < len(hexFingerprint); i += 2 {
+		colons = append(colons, strings.ToUpper(hexFingerprint[i:i+2]))
+	}
+
+	for _, fingerprint := range []string{hexFingerprint, strings.Join(colons, ":")} {
+		b, err := rpcinterface.ParseFingerprint(fingerprint)
+		if err != nil {
+			t.Fatalf("%s: %v", fingerprint, err)
+		}
+		if string(b) != string(sum[:]) {
+			t.Fatalf("%s: parsed to the wrong fingerprint", fingerprint)
+		}
+	}
+
+	if _, err := rpcinterface.ParseFingerprint("abcd"); err == nil {
+		t.Fatal("expected an error for a short fingerprint")
+	}
+}
@@ -49,6 +49,31 @@ type FullBlock struct {
 	TransactionsInfo             *TransactionsInfo        `json:"transactions_info"`
 	TransactionsGenerator        *SerializedProgram       `json:"transactions_generator"`          // @TODO Verify this is correct
 	TransactionsGeneratorRefList []uint32                 `json:"transactions_generator_ref_list"` // @TODO Verify this is correct
+	// HeaderHash is not on the official type, but get_blocks adds it unless exclude_header_hash is set
+	HeaderHash string `json:"header_hash,omitempty"`
+}
+
+// UnfinishedHeaderBlock an unfinished block without the transactions
+type UnfinishedHeaderBlock struct {
+	FinishedSubSlots        []*EndOfSubSlotBundle       `json:"finished_sub_slots"`
+	RewardChainBlock        *RewardChainBlockUnfinished `json:"reward_chain_block"`
+	ChallengeChainSPProof   *VDFProof                   `json:"challenge_chain_sp_proof"`
+	RewardChainSPProof      *VDFProof                   `json:"reward_chain_sp_proof"`
+	Foliage                 *Foliage                    `json:"foliage"`
+	FoliageTransactionBlock *FoliageTransactionBlock    `json:"foliage_transaction_block"`
+	TransactionsFilter      string                      `json:"transactions_filter"`
+}
+
+// RewardChainBlockUnfinished Reward Chain Block, before infusion
+type RewardChainBlockUnfinished struct {
+	TotalIters                Uint128       `json:"total_iters"`
+	SignagePointIndex         uint8         `json:"signage_point_index"`
+	POSSSCCChallengeHash      string        `json:"pos_ss_cc_challenge_hash"`
+	ProofOfSpace              *ProofOfSpace `json:"proof_of_space"`
+	ChallengeChainSPVDF       *VDFInfo      `json:"challenge_chain_sp_vdf"` // Not present for first sp in slot
+	ChallengeChainSPSignature *G2Element    `json:"challenge_chain_sp_signature"`
+	RewardChainSPVDF          *VDFInfo      `json:"reward_chain_sp_vdf"` // Not present for first sp in slot
+	RewardChainSPSignature    *G2Element    `json:"reward_chain_sp_signature"`
 }
 
 // RewardChainBlock Reward Chain Block
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	catMojoInCAT uint64 = 1000
+)
+
+// CATMojo is a special type for amounts of a CAT, in mojos
+// CATs use 1000 mojos per token instead of XCH's 1 trillion, so this is kept separate from Mojo to avoid mixing units
+type CATMojo uint64
+
+// MarshalJSON marshals CATMojo into json
+func (m CATMojo) MarshalJSON() ([]byte, error) {
+	s := strconv.FormatUint(uint64(m), 10)
+	return []byte(s), nil
+}
+
+// UnmarshalJSON unmarshals json data into CATMojo
+func (m *CATMojo) UnmarshalJSON(data []byte) error {
+	mojo, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+	*m = CATMojo(mojo)
+	return nil
+}
+
+// ToCAT converts CAT mojos to whole tokens
+func (m CATMojo) ToCAT() CAT {
+	return CAT(m) / CAT(catMojoInCAT)
+}
+
+// CAT is a special type for amounts of a CAT, in whole tokens
+type CAT float64
+
+// MarshalJSON marshals CAT into json
+func (c CAT) MarshalJSON() ([]byte, error) {
+	s := fmt.Sprintf("%f", c)
+	return []byte(s), nil
+}
+
+// UnmarshalJSON unmarshals json data into CAT
+func (c *CAT) UnmarshalJSON(data []byte) error {
+	x, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+	*c = CAT(x)
+	return nil
+}
+
+// ToCATMojo converts whole tokens to CAT mojos
+func (c CAT) ToCATMojo() CATMojo {
+	return CATMojo(c * CAT(catMojoInCAT))
+}
+
+// CATInfo a known CAT from the default CAT list
+type CATInfo struct {
+	AssetID string `json:"asset_id"`
+	Name    string `json:"name"`
+	Symbol  string `json:"symbol"`
+}
@@ -77,3 +77,13 @@ type CoinAddedEvent struct {
 	State    string `json:"state"`
 	WalletID uint32 `json:"wallet_id"`
 }
+
+// CoinRecord a coin along with the heights it was confirmed and spent at
+type CoinRecord struct {
+	Coin                *Coin  `json:"coin"`
+	ConfirmedBlockIndex uint32 `json:"confirmed_block_index"`
+	SpentBlockIndex     uint32 `json:"spent_block_index"` // 0 if the coin is unspent
+	Spent               bool   `json:"spent"`
+	Coinbase            bool   `json:"coinbase"`
+	Timestamp           uint64 `json:"timestamp"` // @TODO time.Time?
+}
@@ -0,0 +1,25 @@
+package types
+
+// KeyData a key from the daemon's keychain
+// Secrets is only set when they were requested
+type KeyData struct {
+	Fingerprint uint32          `json:"fingerprint"`
+	PublicKey   G1Element       `json:"public_key"`
+	Label       *string         `json:"label"`
+	Secrets     *KeyDataSecrets `json:"secrets"`
+}
+
+// KeyDataSecrets the secret parts of a key from the daemon's keychain
+type KeyDataSecrets struct {
+	Mnemonic   []string `json:"mnemonic"`
+	Entropy    string   `json:"entropy"`
+	PrivateKey string   `json:"private_key"`
+}
+
+// PlotterInfo information about a plotter the daemon can use
+type PlotterInfo struct {
+	DisplayName string `json:"display_name"`
+	Installed   bool   `json:"installed"`
+	CanInstall  bool   `json:"can_install"`
+	Version     string `json:"version"`
+}
@@ -0,0 +1,69 @@
+package types
+
+// DataLayerChangeAction the action to take for a single change in batch_update
+type DataLayerChangeAction string
+
+const (
+	// DataLayerChangeActionInsert inserts a key/value pair
+	DataLayerChangeActionInsert DataLayerChangeAction = "insert"
+
+	// DataLayerChangeActionDelete deletes a key
+	DataLayerChangeActionDelete DataLayerChangeAction = "delete"
+)
+
+// DataLayerChange a single change to a data store. Keys and values are hex encoded
+// Value is only used for inserts
+type DataLayerChange struct {
+	Action DataLayerChangeAction `json:"action"`
+	Key    string                `json:"key"`
+	Value  string                `json:"value,omitempty"`
+}
+
+// DataLayerKeyValue a single key/value pair in a data store. Keys and values are hex encoded
+type DataLayerKeyValue struct {
+	Hash  string `json:"hash"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DataLayerRoot the root hash of a data store at a point in time
+type DataLayerRoot struct {
+	ID        string `json:"id"`
+	Hash      string `json:"hash"`
+	Confirmed bool   `json:"confirmed"`
+	Timestamp uint64 `json:"timestamp"` // @TODO time.Time?
+}
+
+// DataLayerRootHistory a single entry in the root history of a data store
+type DataLayerRootHistory struct {
+	RootHash  string `json:"root_hash"`
+	Confirmed bool   `json:"confirmed"`
+	Timestamp uint64 `json:"timestamp"` // @TODO time.Time?
+}
+
+// DataLayerDiffType whether a key was inserted or deleted between two roots
+type DataLayerDiffType string
+
+const (
+	// DataLayerDiffTypeInsert the key was inserted
+	DataLayerDiffTypeInsert DataLayerDiffType = "INSERT"
+
+	// DataLayerDiffTypeDelete the key was deleted
+	DataLayerDiffTypeDelete DataLayerDiffType = "DELETE"
+)
+
+// DataLayerDiff a single key that changed between two roots
+type DataLayerDiff struct {
+	Type  DataLayerDiffType `json:"type"`
+	Key   string            `json:"key"`
+	Value string            `json:"value"`
+}
+
+// DataLayerMirror a mirror of a data store, where the data can be downloaded from
+type DataLayerMirror struct {
+	CoinID     string   `json:"coin_id"`
+	LauncherID string   `json:"launcher_id"`
+	Amount     Mojo     `json:"amount"`
+	URLs       []string `json:"urls"`
+	Ours       bool     `json:"ours"`
+}
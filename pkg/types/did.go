@@ -0,0 +1,16 @@
+package types
+
+// DIDInfo information about a DID, as returned by did_get_info
+type DIDInfo struct {
+	DIDID            string            `json:"did_id"`
+	LatestCoin       string            `json:"latest_coin"`
+	P2Address        Address           `json:"p2_address"`
+	PublicKey        string            `json:"public_key"`
+	RecoveryListHash *string           `json:"recovery_list_hash"`
+	NumVerification  uint64            `json:"num_verification"`
+	Metadata         map[string]string `json:"metadata"`
+	LauncherID       string            `json:"launcher_id"`
+	FullPuzzle       SerializedProgram `json:"full_puzzle"`
+	Solution         interface{}       `json:"solution"` // @TODO the solution is returned as a python list of arbitrary depth
+	Hints            []string          `json:"hints"`
+}
@@ -0,0 +1,128 @@
+package types
+
+import "encoding/json"
+
+// FarmerSignagePoint is a signage point the farmer has received, along with any proofs found for it
+type FarmerSignagePoint struct {
+	SignagePoint *NewSignagePoint     `json:"signage_point"`
+	Proofs       []*SignagePointProof `json:"proofs"`
+}
+
+// SignagePointProof is a proof of space found for a signage point and the plot it was found in
+type SignagePointProof struct {
+	PlotIdentifier string
+	ProofOfSpace   *ProofOfSpace
+}
+
+// UnmarshalJSON unmarshals the [plot_identifier, proof_of_space] tuple
+func (p *SignagePointProof) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &p.PlotIdentifier, &p.ProofOfSpace)
+}
+
+// MarshalJSON marshals back into the [plot_identifier, proof_of_space] tuple
+func (p *SignagePointProof) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{p.PlotIdentifier, p.ProofOfSpace})
+}
+
+// PoolConfig the pool configuration for a single plot NFT (pool_list in config.yaml)
+type PoolConfig struct {
+	LauncherID            string     `json:"launcher_id"`
+	PoolURL               string     `json:"pool_url"`
+	PayoutInstructions    string     `json:"payout_instructions"`
+	TargetPuzzleHash      PuzzleHash `json:"target_puzzle_hash"`
+	P2SingletonPuzzleHash PuzzleHash `json:"p2_singleton_puzzle_hash"`
+	OwnerPublicKey        G1Element  `json:"owner_public_key"`
+}
+
+// PoolPoints number of points found or acknowledged at a specific time
+type PoolPoints struct {
+	Timestamp float64 // @TODO time.Time?
+	Points    uint64
+}
+
+// UnmarshalJSON unmarshals the [timestamp, points] tuple
+func (p *PoolPoints) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &p.Timestamp, &p.Points)
+}
+
+// MarshalJSON marshals back into the [timestamp, points] tuple
+func (p *PoolPoints) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{p.Timestamp, p.Points})
+}
+
+// PoolErrorResponse an error returned by the pool
+type PoolErrorResponse struct {
+	ErrorCode    uint16 `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// PoolError an error the pool returned at a specific time
+type PoolError struct {
+	Timestamp float64 // @TODO time.Time?
+	Error     *PoolErrorResponse
+}
+
+// UnmarshalJSON unmarshals the [timestamp, error] tuple
+func (p *PoolError) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &p.Timestamp, &p.Error)
+}
+
+// MarshalJSON marshals back into the [timestamp, error] tuple
+func (p *PoolError) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{p.Timestamp, p.Error})
+}
+
+// FarmerPoolState the farmer's view of a single pool it is farming to
+type FarmerPoolState struct {
+	P2SingletonPuzzleHash        PuzzleHash    `json:"p2_singleton_puzzle_hash"`
+	PointsFoundSinceStart        uint64        `json:"points_found_since_start"`
+	PointsFound24h               []*PoolPoints `json:"points_found_24h"`
+	PointsAcknowledgedSinceStart uint64        `json:"points_acknowledged_since_start"`
+	PointsAcknowledged24h        []*PoolPoints `json:"points_acknowledged_24h"`
+	NextFarmerUpdate             float64       `json:"next_farmer_update"`
+	NextPoolInfoUpdate           float64       `json:"next_pool_info_update"`
+	CurrentPoints                uint64        `json:"current_points"`
+	CurrentDifficulty            uint64        `json:"current_difficulty"`
+	PoolErrors24h                []*PoolError  `json:"pool_errors_24h"`
+	AuthenticationTokenTimeout   uint8         `json:"authentication_token_timeout"`
+	PoolConfig                   *PoolConfig   `json:"pool_config"`
+	PlotCount                    int           `json:"plot_count"`
+}
+
+// HarvesterConnection connection details of a harvester connected to the farmer
+type HarvesterConnection struct {
+	NodeID string `json:"node_id"`
+	Host   string `json:"host"`
+	Port   uint16 `json:"port"`
+}
+
+// HarvesterSyncing the progress of a harvester syncing its plots to the farmer
+type HarvesterSyncing struct {
+	Initial            bool   `json:"initial"`
+	PlotFilesProcessed uint32 `json:"plot_files_processed"`
+	PlotFilesTotal     uint32 `json:"plot_files_total"`
+}
+
+// HarvesterDetails everything the farmer knows about a single harvester, including all plots
+type HarvesterDetails struct {
+	Connection            *HarvesterConnection `json:"connection"`
+	Plots                 []*PlotInfo          `json:"plots"`
+	FailedToOpenFilenames []string             `json:"failed_to_open_filenames"`
+	NoKeyFilenames        []string             `json:"no_key_filenames"`
+	Duplicates            []string             `json:"duplicates"`
+	TotalPlotSize         uint64               `json:"total_plot_size"`
+	Syncing               *HarvesterSyncing    `json:"syncing"`
+	LastSyncTime          float64              `json:"last_sync_time"` // @TODO time.Time?
+}
+
+// HarvesterSummary the same as HarvesterDetails, but with counts instead of the lists of plots and files
+type HarvesterSummary struct {
+	Connection            *HarvesterConnection `json:"connection"`
+	Plots                 uint32               `json:"plots"`
+	FailedToOpenFilenames uint32               `json:"failed_to_open_filenames"`
+	NoKeyFilenames        uint32               `json:"no_key_filenames"`
+	Duplicates            uint32               `json:"duplicates"`
+	TotalPlotSize         uint64               `json:"total_plot_size"`
+	Syncing               *HarvesterSyncing    `json:"syncing"`
+	LastSyncTime          float64              `json:"last_sync_time"` // @TODO time.Time?
+}
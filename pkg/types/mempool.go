@@ -0,0 +1,39 @@
+package types
+
+// MempoolItem a single spend bundle that is in the mempool
+type MempoolItem struct {
+	SpendBundle     *SpendBundle `json:"spend_bundle"`
+	Fee             Mojo         `json:"fee"`
+	NPCResult       *NPCResult   `json:"npc_result"`
+	Cost            uint64       `json:"cost"`
+	SpendBundleName string       `json:"spend_bundle_name"`
+	Additions       []*Coin      `json:"additions"`
+	Removals        []*Coin      `json:"removals"`
+}
+
+// NPCResult the result of running the spend bundle's generator
+type NPCResult struct {
+	Error *uint16                `json:"error"`
+	Conds *SpendBundleConditions `json:"conds"`
+	Cost  uint64                 `json:"cost"`
+}
+
+// SpendBundleConditions the conditions output by all spends in a spend bundle
+type SpendBundleConditions struct {
+	Spends          []*SpendConditions `json:"spends"`
+	ReserveFee      Mojo               `json:"reserve_fee"`
+	HeightAbsolute  uint32             `json:"height_absolute"`
+	SecondsAbsolute uint64             `json:"seconds_absolute"`
+	Cost            uint64             `json:"cost"`
+	// @TODO agg_sig_unsafe: List[Tuple[G1Element, bytes]]
+}
+
+// SpendConditions the conditions output by a single coin spend
+type SpendConditions struct {
+	CoinID          string     `json:"coin_id"`
+	PuzzleHash      PuzzleHash `json:"puzzle_hash"`
+	HeightRelative  *uint32    `json:"height_relative"`
+	SecondsRelative uint64     `json:"seconds_relative"`
+	// @TODO create_coin: List[Tuple[bytes32, uint64, Optional[bytes]]]
+	// @TODO agg_sig_me: List[Tuple[G1Element, bytes]]
+}
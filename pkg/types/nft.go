@@ -0,0 +1,40 @@
+package types
+
+// NFTInfo information about a single NFT
+type NFTInfo struct {
+	NFTID              string      `json:"nft_id"`
+	LauncherID         string      `json:"launcher_id"`
+	NFTCoinID          string      `json:"nft_coin_id"`
+	OwnerDID           *string     `json:"owner_did"`
+	RoyaltyPercentage  *uint16     `json:"royalty_percentage"` // In basis points, 100 = 1%
+	RoyaltyPuzzleHash  *PuzzleHash `json:"royalty_puzzle_hash"`
+	DataURIs           []string    `json:"data_uris"`
+	DataHash           string      `json:"data_hash"`
+	MetadataURIs       []string    `json:"metadata_uris"`
+	MetadataHash       string      `json:"metadata_hash"`
+	LicenseURIs        []string    `json:"license_uris"`
+	LicenseHash        string      `json:"license_hash"`
+	EditionTotal       uint64      `json:"edition_total"`
+	EditionNumber      uint64      `json:"edition_number"`
+	UpdaterPuzhash     PuzzleHash  `json:"updater_puzhash"`
+	ChainInfo          string      `json:"chain_info"`
+	MintHeight         uint32      `json:"mint_height"`
+	SupportsDID        bool        `json:"supports_did"`
+	P2Address          PuzzleHash  `json:"p2_address"`
+	PendingTransaction bool        `json:"pending_transaction"`
+	MinterDID          *string     `json:"minter_did"`
+	LauncherPuzhash    PuzzleHash  `json:"launcher_puzhash"`
+	OffChainMetadata   *string     `json:"off_chain_metadata"`
+}
+
+// NFTMintMetadata the metadata for a single NFT when minting in bulk
+type NFTMintMetadata struct {
+	URIs          []string `json:"uris"`
+	MetaURIs      []string `json:"meta_uris,omitempty"`
+	LicenseURIs   []string `json:"license_uris,omitempty"`
+	Hash          string   `json:"hash"`
+	MetaHash      string   `json:"meta_hash,omitempty"`
+	LicenseHash   string   `json:"license_hash,omitempty"`
+	EditionNumber uint64   `json:"edition_number,omitempty"`
+	EditionTotal  uint64   `json:"edition_total,omitempty"`
+}
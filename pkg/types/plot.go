@@ -0,0 +1,13 @@
+package types
+
+// PlotInfo information about a single plot
+type PlotInfo struct {
+	Filename               string      `json:"filename"`
+	Size                   uint8       `json:"size"`
+	PlotID                 string      `json:"plot_id"`
+	PoolPublicKey          *G1Element  `json:"pool_public_key"`           // Only one of these two should be present
+	PoolContractPuzzleHash *PuzzleHash `json:"pool_contract_puzzle_hash"` // Only one of these two should be present
+	PlotPublicKey          *G1Element  `json:"plot_public_key"`
+	FileSize               uint64      `json:"file_size"`
+	TimeModified           float64     `json:"time_modified"` // @TODO time.Time?
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PoolSingletonState the state of a plot NFT
+// These values match values in chia blockchain. Must not be arbitrarily changed
+type PoolSingletonState uint8
+
+const (
+	// PoolSingletonStateSelfPooling farming rewards go to the owner
+	PoolSingletonStateSelfPooling = PoolSingletonState(1)
+
+	// PoolSingletonStateLeavingPool waiting for the relative lock height to pass before leaving the pool
+	PoolSingletonStateLeavingPool = PoolSingletonState(2)
+
+	// PoolSingletonStateFarmingToPool farming rewards go to the pool
+	PoolSingletonStateFarmingToPool = PoolSingletonState(3)
+)
+
+// String returns the name chia uses for the state
+func (s PoolSingletonState) String() string {
+	switch s {
+	case PoolSingletonStateSelfPooling:
+		return "SELF_POOLING"
+	case PoolSingletonStateLeavingPool:
+		return "LEAVING_POOL"
+	case PoolSingletonStateFarmingToPool:
+		return "FARMING_TO_POOL"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(s))
+}
+
+// MarshalJSON marshals the state as its name, which is what chia expects in requests
+func (s PoolSingletonState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshals the state from either the numeric value (as returned in pool state) or the name
+func (s *PoolSingletonState) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var value uint8
+		if err = json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*s = PoolSingletonState(value)
+		return nil
+	}
+
+	for status := PoolSingletonStateSelfPooling; status <= PoolSingletonStateFarmingToPool; status++ {
+		if strings.EqualFold(status.String(), name) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown pool singleton state: %s", name)
+}
+
+// PoolState the state of a plot NFT, as stored on chain
+type PoolState struct {
+	Version            uint8              `json:"version"`
+	State              PoolSingletonState `json:"state"`
+	TargetPuzzleHash   PuzzleHash         `json:"target_puzzle_hash"`
+	OwnerPubkey        G1Element          `json:"owner_pubkey"`
+	PoolURL            *string            `json:"pool_url"`
+	RelativeLockHeight uint32             `json:"relative_lock_height"`
+}
+
+// PoolWalletInfo the wallet's view of a plot NFT
+// Target is only set when the plot NFT is transitioning to a new state
+type PoolWalletInfo struct {
+	Current               *PoolState `json:"current"`
+	Target                *PoolState `json:"target"`
+	LauncherCoin          *Coin      `json:"launcher_coin"`
+	LauncherID            string     `json:"launcher_id"`
+	P2SingletonPuzzleHash PuzzleHash `json:"p2_singleton_puzzle_hash"`
+	CurrentInner          string     `json:"current_inner"`
+	TipSingletonCoinID    string     `json:"tip_singleton_coin_id"`
+	SingletonBlockHeight  uint32     `json:"singleton_block_height"`
+}
+
+// PoolTarget returns the pool target used by blocks farmed with plots assigned to this plot NFT
+// Rewards are always sent to the p2 singleton, and claimed by the pool or the owner from there
+// This matches FarmerPoolState.P2SingletonPuzzleHash from the farmer's get_pool_state
+func (p *PoolWalletInfo) PoolTarget() *PoolTarget {
+	puzzleHash := p.P2SingletonPuzzleHash
+	return &PoolTarget{
+		PuzzleHash: &puzzleHash,
+		MaxHeight:  0,
+	}
+}
@@ -10,9 +10,17 @@ type SignagePointEvent struct {
 // @TODO this is a protocol/streamable message that should be in lib
 type NewSignagePoint struct {
 	ChallengeHash      string `json:"challenge_hash"`
-	ChallengeChainHash string `json:"challenge_chain_hash"`
+	ChallengeChainHash string `json:"challenge_chain_sp"`
 	RewardChainSP      string `json:"reward_chain_sp"`
 	Difficulty         uint64 `json:"difficulty"`
 	SubSlotIters       uint64 `json:"sub_slot_iters"`
 	SignagePointIndex  uint8  `json:"signage_point_index"`
 }
+
+// SignagePoint the VDFs and proofs for a signage point
+type SignagePoint struct {
+	CCVDF   *VDFInfo  `json:"cc_vdf"`
+	CCProof *VDFProof `json:"cc_proof"`
+	RCVDF   *VDFInfo  `json:"rc_vdf"`
+	RCProof *VDFProof `json:"rc_proof"`
+}
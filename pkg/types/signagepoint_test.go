@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestSignagePointEventUnmarshal(t *testing.T) {
+	// The signage_point event the farmer sends to the metrics service, carrying a farmer_protocol.NewSignagePoint
+	input := `{
+		"success": true,
+		"broadcast_farmer": {
+			"challenge_hash": "0x1111111111111111111111111111111111111111111111111111111111111111",
+			"challenge_chain_sp": "0x2222222222222222222222222222222222222222222222222222222222222222",
+			"reward_chain_sp": "0x3333333333333333333333333333333333333333333333333333333333333333",
+			"difficulty": 2816,
+			"sub_slot_iters": 147849216,
+			"signage_point_index": 12
+		}
+	}`
+
+	event := &types.SignagePointEvent{}
+	if err := json.Unmarshal([]byte(input), event); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	sp := event.BroadcastFarmer
+	if sp.ChallengeHash != "0x1111111111111111111111111111111111111111111111111111111111111111" {
+		t.Errorf("unexpected challenge hash: %s", sp.ChallengeHash)
+	}
+	if sp.ChallengeChainHash != "0x2222222222222222222222222222222222222222222222222222222222222222" {
+		t.Errorf("expected challenge_chain_sp to decode into ChallengeChainHash, got %q", sp.ChallengeChainHash)
+	}
+	if sp.RewardChainSP != "0x3333333333333333333333333333333333333333333333333333333333333333" {
+		t.Errorf("unexpected reward chain sp: %s", sp.RewardChainSP)
+	}
+	if sp.Difficulty != 2816 || sp.SubSlotIters != 147849216 || sp.SignagePointIndex != 12 {
+		t.Errorf("unexpected signage point: %+v", sp)
+	}
+}
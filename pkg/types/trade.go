@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TradeStatus status of a trade/offer
+// These values match values in chia blockchain. Must not be arbitrarily changed
+type TradeStatus uint8
+
+const (
+	// TradeStatusPendingAccept offer created, waiting for someone to take it
+	TradeStatusPendingAccept = TradeStatus(0)
+
+	// TradeStatusPendingConfirm offer taken, waiting for the transaction to be confirmed
+	TradeStatusPendingConfirm = TradeStatus(1)
+
+	// TradeStatusPendingCancel cancellation transaction submitted, waiting for it to be confirmed
+	TradeStatusPendingCancel = TradeStatus(2)
+
+	// TradeStatusCancelled offer was cancelled
+	TradeStatusCancelled = TradeStatus(3)
+
+	// TradeStatusConfirmed offer was taken and confirmed on chain
+	TradeStatusConfirmed = TradeStatus(4)
+
+	// TradeStatusFailed offer failed
+	TradeStatusFailed = TradeStatus(5)
+)
+
+// String returns the name chia uses for the status
+func (s TradeStatus) String() string {
+	switch s {
+	case TradeStatusPendingAccept:
+		return "PENDING_ACCEPT"
+	case TradeStatusPendingConfirm:
+		return "PENDING_CONFIRM"
+	case TradeStatusPendingCancel:
+		return "PENDING_CANCEL"
+	case TradeStatusCancelled:
+		return "CANCELLED"
+	case TradeStatusConfirmed:
+		return "CONFIRMED"
+	case TradeStatusFailed:
+		return "FAILED"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(s))
+}
+
+// UnmarshalJSON unmarshals the status from either the numeric value or the name (as returned by the trade RPCs)
+func (s *TradeStatus) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var value uint8
+		if err = json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*s = TradeStatus(value)
+		return nil
+	}
+
+	for status := TradeStatusPendingAccept; status <= TradeStatusFailed; status++ {
+		if strings.EqualFold(status.String(), name) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown trade status: %s", name)
+}
+
+// OfferAssets the amount of each asset in an offer, keyed by asset ID, or "xch" for XCH
+// XCH amounts are in mojos and CAT amounts are in CAT mojos, so use XCH and CAT to read amounts in the right unit
+type OfferAssets map[string]uint64
+
+// OfferAssetXCH is the key XCH amounts use in OfferAssets
+const OfferAssetXCH = "xch"
+
+// XCH returns the amount of XCH
+func (a OfferAssets) XCH() Mojo {
+	return Mojo(a[OfferAssetXCH])
+}
+
+// CAT returns the amount of the CAT with the asset ID
+func (a OfferAssets) CAT(assetID string) CATMojo {
+	return CATMojo(a[assetID])
+}
+
+// OfferSummary the assets offered and requested in an offer
+type OfferSummary struct {
+	Offered   OfferAssets `json:"offered"`
+	Requested OfferAssets `json:"requested"`
+	Fees      Mojo        `json:"fees"`
+}
+
+// TradeRecord a single trade (offer) made or taken by the wallet
+type TradeRecord struct {
+	ConfirmedAtIndex uint32        `json:"confirmed_at_index"`
+	AcceptedAtTime   *uint64       `json:"accepted_at_time"` // @TODO time.Time?
+	CreatedAtTime    uint64        `json:"created_at_time"`  // @TODO time.Time?
+	IsMyOffer        bool          `json:"is_my_offer"`
+	Sent             uint32        `json:"sent"`
+	CoinsOfInterest  []*Coin       `json:"coins_of_interest"`
+	TradeID          string        `json:"trade_id"`
+	Status           TradeStatus   `json:"status"`
+	SentTo           []*SentTo     `json:"sent_to"`
+	Summary          *OfferSummary `json:"summary"`
+	// Pending is the amount of each asset locked in this offer, keyed the same as the summary
+	Pending OfferAssets `json:"pending"`
+}
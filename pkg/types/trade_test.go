@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestOfferSummaryAmounts(t *testing.T) {
+	assetID := "6d95dae356e32a71db5ddcb42224754a02524c615c5fc35f568c2af04774e589"
+	input := `{
+		"offered": {"xch": 1500000000000},
+		"requested": {"` + assetID + `": 25000},
+		"fees": 1000
+	}`
+
+	summary := &types.OfferSummary{}
+	if err := json.Unmarshal([]byte(input), summary); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if summary.Offered.XCH() != types.Mojo(1500000000000) {
+		t.Errorf("unexpected offered XCH: %d", summary.Offered.XCH())
+	}
+	if summary.Requested.CAT(assetID) != types.CATMojo(25000) {
+		t.Errorf("unexpected requested CAT: %d", summary.Requested.CAT(assetID))
+	}
+	if summary.Requested.CAT(assetID).ToCAT() != types.CAT(25) {
+		t.Errorf("expected 25 CAT, got %f", summary.Requested.CAT(assetID).ToCAT())
+	}
+	if summary.Requested.XCH() != 0 {
+		t.Errorf("expected no requested XCH, got %d", summary.Requested.XCH())
+	}
+	if summary.Fees != types.Mojo(1000) {
+		t.Errorf("unexpected fees: %d", summary.Fees)
+	}
+}
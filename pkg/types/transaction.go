@@ -1,39 +1,78 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // TransactionRecord Single Transaction
 type TransactionRecord struct {
-	ConfirmedAtHeight uint32       `json:"confirmed_at_height"`
-	CreatedAtTime     uint64       `json:"created_at_time"` // @TODO time.Time?
-	ToPuzzleHash      *PuzzleHash  `json:"to_puzzle_hash"`
-	Amount            uint64       `json:"amount"`
-	FeeAmount         uint64       `json:"fee_amount"`
-	Confirmed         bool         `json:"confirmed"`
-	Sent              uint32       `json:"sent"`
-	SpendBundle       *SpendBundle `json:"spend_bundle"`
-	Additions         []*Coin      `json:"additions"`
-	Removals          []*Coin      `json:"removals"`
-	WalletID          uint32       `json:"wallet_id"`
-	//SentTo            SentTo          `json:"sent_to"` // @TODO need to properly unserialize this
-	TradeID string           `json:"trade_id"`
-	Type    *TransactionType `json:"type"`
-	Name    string           `json:"name"` // @TODO bytes32 / hex
+	ConfirmedAtHeight uint32           `json:"confirmed_at_height"`
+	CreatedAtTime     uint64           `json:"created_at_time"` // @TODO time.Time?
+	ToPuzzleHash      *PuzzleHash      `json:"to_puzzle_hash"`
+	Amount            uint64           `json:"amount"`
+	FeeAmount         uint64           `json:"fee_amount"`
+	Confirmed         bool             `json:"confirmed"`
+	Sent              uint32           `json:"sent"`
+	SpendBundle       *SpendBundle     `json:"spend_bundle"`
+	Additions         []*Coin          `json:"additions"`
+	Removals          []*Coin          `json:"removals"`
+	WalletID          uint32           `json:"wallet_id"`
+	SentTo            []*SentTo        `json:"sent_to"`
+	TradeID           string           `json:"trade_id"`
+	Type              *TransactionType `json:"type"`
+	Name              string           `json:"name"` // @TODO bytes32 / hex
 	// ToAddress is not on the official type, but some endpoints return it anyways
 	ToAddress *Address `json:"to_address"`
 }
 
+// IsTrade returns true if the transaction is part of a trade
+// TradeID can be used to look up the TradeRecord with get_offer
+func (t *TransactionRecord) IsTrade() bool {
+	if t.Type == nil {
+		return false
+	}
+
+	return *t.Type == TransactionTypeIncomingTrade || *t.Type == TransactionTypeOutgoingTrade
+}
+
 // Address Own type for future methods to encode/decode
 type Address string
 
 // SentTo Represents the list of peers that we sent the transaction to, whether each one
 // included it in the mempool, and what the error message (if any) was
 // sent_to: List[Tuple[str, uint8, Optional[str]]]
-// @TODO need to parse from the json
 type SentTo struct {
 	Peer                   string
 	MempoolInclusionStatus *MempoolInclusionStatus
 	Error                  string
 }
 
+// UnmarshalJSON unmarshals the [peer, status, error] tuple
+func (s *SentTo) UnmarshalJSON(data []byte) error {
+	var errMsg *string
+	err := unmarshalTuple(data, &s.Peer, &s.MempoolInclusionStatus, &errMsg)
+	if err != nil {
+		return err
+	}
+	if errMsg != nil {
+		s.Error = *errMsg
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals back into the [peer, status, error] tuple
+func (s *SentTo) MarshalJSON() ([]byte, error) {
+	var errMsg *string
+	if s.Error != "" {
+		errMsg = &s.Error
+	}
+
+	return json.Marshal([]interface{}{s.Peer, s.MempoolInclusionStatus, errMsg})
+}
+
 // MempoolInclusionStatus status of being included in the mempool
 type MempoolInclusionStatus uint8
 
@@ -48,6 +87,42 @@ const (
 	MempoolInclusionStatusFailed = MempoolInclusionStatus(3)
 )
 
+// String returns the name chia uses for the status
+func (s MempoolInclusionStatus) String() string {
+	switch s {
+	case MempoolInclusionStatusSuccess:
+		return "SUCCESS"
+	case MempoolInclusionStatusPending:
+		return "PENDING"
+	case MempoolInclusionStatusFailed:
+		return "FAILED"
+	}
+
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(s))
+}
+
+// UnmarshalJSON unmarshals the status from either the numeric value or the name (as returned by push_tx)
+func (s *MempoolInclusionStatus) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var value uint8
+		if err = json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*s = MempoolInclusionStatus(value)
+		return nil
+	}
+
+	for _, status := range []MempoolInclusionStatus{MempoolInclusionStatusSuccess, MempoolInclusionStatusPending, MempoolInclusionStatusFailed} {
+		if strings.EqualFold(status.String(), name) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown mempool inclusion status: %s", name)
+}
+
 // TransactionType type of transaction
 type TransactionType uint32
 
@@ -72,7 +147,10 @@ const (
 )
 
 // SpendBundle Spend Bundle...
+// Chia renamed coin_solutions to coin_spends. Responses from newer nodes populate CoinSpends, and only one of the
+// two may be set when sending a spend bundle to the node
 type SpendBundle struct {
 	AggregatedSignature string          `json:"aggregated_signature"`
-	CoinSolutions       []*CoinSolution `json:"coin_solutions"`
+	CoinSolutions       []*CoinSolution `json:"coin_solutions,omitempty"`
+	CoinSpends          []*CoinSolution `json:"coin_spends,omitempty"`
 }
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestMempoolInclusionStatusUnmarshal(t *testing.T) {
+	tests := map[string]types.MempoolInclusionStatus{
+		`"SUCCESS"`: types.MempoolInclusionStatusSuccess,
+		`"PENDING"`: types.MempoolInclusionStatusPending,
+		`"FAILED"`:  types.MempoolInclusionStatusFailed,
+		`1`:         types.MempoolInclusionStatusSuccess,
+		`3`:         types.MempoolInclusionStatusFailed,
+	}
+
+	for input, expected := range tests {
+		var status types.MempoolInclusionStatus
+		if err := json.Unmarshal([]byte(input), &status); err != nil {
+			t.Fatalf("unexpected error unmarshaling %s: %s", input, err.Error())
+		}
+		if status != expected {
+			t.Errorf("%s: expected %s, got %s", input, expected, status)
+		}
+	}
+
+	var status types.MempoolInclusionStatus
+	if err := json.Unmarshal([]byte(`"NOPE"`), &status); err == nil {
+		t.Error("expected error for unknown status name")
+	}
+}
+
+func TestSentToUnmarshal(t *testing.T) {
+	input := `[["peer1", 1, null], ["peer2", 3, "DOUBLE_SPEND"]]`
+
+	var sentTo []*types.SentTo
+	if err := json.Unmarshal([]byte(input), &sentTo); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if len(sentTo) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(sentTo))
+	}
+	if sentTo[0].Peer != "peer1" || *sentTo[0].MempoolInclusionStatus != types.MempoolInclusionStatusSuccess || sentTo[0].Error != "" {
+		t.Errorf("unexpected first entry: %+v", sentTo[0])
+	}
+	if sentTo[1].Peer != "peer2" || *sentTo[1].MempoolInclusionStatus != types.MempoolInclusionStatusFailed || sentTo[1].Error != "DOUBLE_SPEND" {
+		t.Errorf("unexpected second entry: %+v", sentTo[1])
+	}
+
+	out, err := json.Marshal(sentTo)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if string(out) != `[["peer1",1,null],["peer2",3,"DOUBLE_SPEND"]]` {
+		t.Errorf("unexpected round trip: %s", out)
+	}
+
+	if err = json.Unmarshal([]byte(`[["peer1", 1]]`), &sentTo); err == nil {
+		t.Error("expected error for short tuple")
+	}
+}
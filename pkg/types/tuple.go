@@ -0,0 +1,28 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// unmarshalTuple unmarshals a json array (how chia serializes python tuples) into the provided values, in order
+func unmarshalTuple(data []byte, values ...interface{}) error {
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	if len(raw) != len(values) {
+		return fmt.Errorf("expected tuple of length %d, got %d", len(values), len(raw))
+	}
+
+	for i, value := range values {
+		err = json.Unmarshal(raw[i], value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
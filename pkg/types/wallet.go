@@ -26,11 +26,26 @@ const (
 	// WalletTypeColouredCoin Coloured Coin
 	WalletTypeColouredCoin = WalletType(6)
 
+	// WalletTypeCAT CAT Wallet. Coloured coins were renamed to CATs (Chia Asset Tokens)
+	WalletTypeCAT = WalletTypeColouredCoin
+
 	// WalletTypeRecoverable Recoverable Wallet
 	WalletTypeRecoverable = WalletType(7)
 
 	// WalletTypeDistributedID DID Wallet
 	WalletTypeDistributedID = WalletType(8)
+
+	// WalletTypePooling Pool Wallet (plot NFT)
+	WalletTypePooling = WalletType(9)
+
+	// WalletTypeNFT NFT Wallet
+	WalletTypeNFT = WalletType(10)
+
+	// WalletTypeDataLayer DataLayer Wallet
+	WalletTypeDataLayer = WalletType(11)
+
+	// WalletTypeDataLayerOffer DataLayer Offer Wallet
+	WalletTypeDataLayerOffer = WalletType(12)
 )
 
 // WalletInfo single wallet record
@@ -53,3 +68,13 @@ type WalletBalance struct {
 	UnspentCoinCount         int64   `json:"unspent_coin_count"`
 	PendingCoinRemovalCount  int64   `json:"pending_coin_removal_count"`
 }
+
+// PrivateKey a private key from the keychain
+type PrivateKey struct {
+	Fingerprint uint32    `json:"fingerprint"`
+	SK          string    `json:"sk"`
+	PK          G1Element `json:"pk"`
+	FarmerPK    G1Element `json:"farmer_pk"`
+	PoolPK      G1Element `json:"pool_pk"`
+	Seed        string    `json:"seed"` // The mnemonic, if the key was added from one
+}
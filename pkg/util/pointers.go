@@ -4,3 +4,13 @@ package util
 func IntPtr(i int) *int {
 	return &i
 }
+
+// Uint32Ptr returns a pointer for the provided uint32
+func Uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+// BoolPtr returns a pointer for the provided bool
+func BoolPtr(b bool) *bool {
+	return &b
+}
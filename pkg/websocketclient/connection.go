@@ -0,0 +1,314 @@
+package websocketclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// controlWriteTimeout is how long writing a control message, such as a ping, may take
+const controlWriteTimeout = 10 * time.Second
+
+// ErrPongTimeout is the error a connection is closed with when the server doesn't answer a ping in time
+var ErrPongTimeout = errors.New("no pong received before the pong timeout")
+
+// Keepalive configures how a connection detects that the server has gone away without closing the connection,
+// such as after a network change or the host sleeping
+type Keepalive struct {
+	// PingInterval is how often a ping is sent to the server. 0 disables pings
+	PingInterval time.Duration
+
+	// PongTimeout is how long to wait for the pong after sending a ping before the connection is considered dead
+	// 0 sends pings without waiting for the pong
+	PongTimeout time.Duration
+
+	// ReadTimeout is how long the connection may go without receiving anything, including pongs, before it is
+	// considered dead. 0 disables the read deadline
+	ReadTimeout time.Duration
+}
+
+// DefaultKeepalive returns the keepalive settings used when none are set
+// Pings every 30 seconds, and waits 10 seconds for the pong
+func DefaultKeepalive() Keepalive {
+	return Keepalive{
+		PingInterval: 30 * time.Second,
+		PongTimeout:  10 * time.Second,
+	}
+}
+
+// connection is a single websocket connection to the daemon
+// All writes go through the connection's writer goroutine, since gorilla/websocket only supports one concurrent writer
+type connection struct {
+	// readingSince is when the reader goroutine last started waiting in ReadMessage, as unix nanoseconds, or 0 while
+	// it is handling a message. It is first so it is 64-bit aligned for atomic operations on 32-bit platforms
+	readingSince int64
+
+	ws        *websocket.Conn
+	writes    chan *writeRequest
+	keepalive Keepalive
+
+	// pongs receives a value every time the server answers a ping
+	pongs chan struct{}
+
+	// done is closed once the connection has failed or been closed
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// lock guards err and pending
+	lock sync.Mutex
+	err  error
+
+	// pending holds a channel for every request that is waiting on a response, keyed by request_id
+	pending map[string]chan pendingResult
+}
+
+// pendingResult is the outcome of a request waiting on a response
+type pendingResult struct {
+	resp *types.WebsocketResponse
+	err  error
+}
+
+// closeMessage is queued on the writer goroutine to send a close frame instead of a JSON message
+type closeMessage struct {
+	deadline time.Time
+}
+
+// writeRequest is a message waiting to be written by the writer goroutine
+type writeRequest struct {
+	message  interface{}
+	deadline time.Time
+	result   chan error
+}
+
+func newConnection(ws *websocket.Conn, keepalive Keepalive) *connection {
+	conn := &connection{
+		ws:        ws,
+		writes:    make(chan *writeRequest),
+		keepalive: keepalive,
+		pongs:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+		pending:   map[string]chan pendingResult{},
+	}
+
+	// The pong handler is called from the reader goroutine while it is reading
+	ws.SetPongHandler(func(string) error {
+		select {
+		case conn.pongs <- struct{}{}:
+		default:
+		}
+
+		return conn.extendReadDeadline()
+	})
+
+	return conn
+}
+
+// startReading records that the reader goroutine is about to wait in ReadMessage, where pongs are processed
+func (conn *connection) startReading() {
+	atomic.StoreInt64(&conn.readingSince, time.Now().UnixNano())
+}
+
+// stopReading records that the reader goroutine is handling a message, so pongs can't be processed until it is done
+func (conn *connection) stopReading() {
+	atomic.StoreInt64(&conn.readingSince, 0)
+}
+
+// pongWaitRemaining returns how much longer to wait for a pong before the connection is considered dead
+// Pongs are only processed while the reader goroutine is in ReadMessage, so only time spent there counts towards the
+// pong timeout. A slow handler for a message doesn't make a healthy connection look dead
+func (conn *connection) pongWaitRemaining() time.Duration {
+	readingSince := atomic.LoadInt64(&conn.readingSince)
+	if readingSince == 0 {
+		return conn.keepalive.PongTimeout
+	}
+
+	return conn.keepalive.PongTimeout - time.Since(time.Unix(0, readingSince))
+}
+
+// extendReadDeadline pushes the read deadline out by the read timeout, since something was just received
+// Must only be called from the reader goroutine, or before it starts
+func (conn *connection) extendReadDeadline() error {
+	if conn.keepalive.ReadTimeout <= 0 {
+		return nil
+	}
+
+	return conn.ws.SetReadDeadline(time.Now().Add(conn.keepalive.ReadTimeout))
+}
+
+// write queues the message for the writer goroutine and waits until it has been written
+// The write deadline is taken from ctx
+func (conn *connection) write(ctx context.Context, message interface{}) error {
+	deadline, _ := ctx.Deadline()
+	request := &writeRequest{
+		message:  message,
+		deadline: deadline,
+		result:   make(chan error, 1),
+	}
+
+	select {
+	case conn.writes <- request:
+	case <-conn.done:
+		return conn.closeErr()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-request.result:
+		return err
+	case <-conn.done:
+		return conn.closeErr()
+	}
+}
+
+// writeLoop writes every queued message to the connection, and sends pings, until the connection is closed
+// Closes the connection with ErrPongTimeout if a ping isn't answered after the reader goroutine has spent the pong
+// timeout waiting in ReadMessage
+func (conn *connection) writeLoop() {
+	var ping <-chan time.Time
+	if conn.keepalive.PingInterval > 0 {
+		ticker := time.NewTicker(conn.keepalive.PingInterval)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+
+	// pongTimeout is nil unless a ping is waiting on its pong
+	var pongTimer *time.Timer
+	var pongTimeout <-chan time.Time
+	defer func() {
+		if pongTimer != nil {
+			pongTimer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case request := <-conn.writes:
+			var err error
+			if closeMsg, ok := request.message.(*closeMessage); ok {
+				err = conn.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), closeMsg.deadline)
+			} else {
+				err = conn.ws.SetWriteDeadline(request.deadline)
+				if err == nil {
+					err = conn.ws.WriteJSON(request.message)
+				}
+			}
+			request.result <- err
+			if err != nil {
+				conn.close(err)
+				return
+			}
+		case <-ping:
+			err := conn.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(controlWriteTimeout))
+			if err != nil {
+				conn.close(err)
+				return
+			}
+			if conn.keepalive.PongTimeout > 0 && pongTimeout == nil {
+				pongTimer = time.NewTimer(conn.keepalive.PongTimeout)
+				pongTimeout = pongTimer.C
+			}
+		case <-conn.pongs:
+			if pongTimer != nil {
+				pongTimer.Stop()
+				pongTimer = nil
+				pongTimeout = nil
+			}
+		case <-pongTimeout:
+			if remaining := conn.pongWaitRemaining(); remaining > 0 {
+				pongTimer.Reset(remaining)
+				continue
+			}
+			conn.close(ErrPongTimeout)
+			return
+		case <-conn.done:
+			return
+		}
+	}
+}
+
+// writeClose sends a normal closure close frame through the writer goroutine
+func (conn *connection) writeClose(ctx context.Context) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(controlWriteTimeout)
+	}
+
+	return conn.write(ctx, &closeMessage{deadline: deadline})
+}
+
+// addPending registers a request ID that is waiting for a response
+// Returns an error if the connection has already been closed
+func (conn *connection) addPending(requestID string) (chan pendingResult, error) {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+
+	if conn.err != nil {
+		return nil, conn.err
+	}
+
+	resultChan := make(chan pendingResult, 1)
+	conn.pending[requestID] = resultChan
+
+	return resultChan, nil
+}
+
+// removePending removes a request ID from the list of requests waiting for a response
+func (conn *connection) removePending(requestID string) {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+
+	delete(conn.pending, requestID)
+}
+
+// resolvePending sends the response to the request waiting for it
+// Returns false if no request is waiting on the response's request ID
+func (conn *connection) resolvePending(resp *types.WebsocketResponse) bool {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+
+	resultChan, ok := conn.pending[resp.RequestID]
+	if !ok {
+		return false
+	}
+	delete(conn.pending, resp.RequestID)
+	resultChan <- pendingResult{resp: resp}
+
+	return true
+}
+
+// close closes the connection, and fails every request waiting for a response with err
+// Only the first call has any effect
+func (conn *connection) close(err error) {
+	conn.closeOnce.Do(func() {
+		if err == nil {
+			err = fmt.Errorf("connection closed")
+		}
+
+		conn.lock.Lock()
+		conn.err = err
+		for requestID, resultChan := range conn.pending {
+			delete(conn.pending, requestID)
+			resultChan <- pendingResult{err: err}
+		}
+		close(conn.done)
+		conn.lock.Unlock()
+
+		_ = conn.ws.Close()
+	})
+}
+
+// closeErr returns the error the connection was closed with
+func (conn *connection) closeErr() error {
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+
+	return conn.err
+}
@@ -0,0 +1,141 @@
+package websocketclient
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestKeepalivePongTimeout(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+	c.SetKeepalive(Keepalive{PingInterval: 20 * time.Millisecond, PongTimeout: 50 * time.Millisecond})
+
+	disconnected := make(chan error, 1)
+	reconnected := make(chan struct{}, 1)
+	c.OnDisconnect(func(err error) {
+		disconnected <- err
+		d.setUnresponsive(false)
+	})
+	c.OnReconnect(func() {
+		reconnected <- struct{}{}
+	})
+
+	if err := c.Subscribe("metrics"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pings are answered, so the connection should stay up
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case err := <-disconnected:
+		t.Fatalf("unexpected disconnect: %v", err)
+	default:
+	}
+
+	d.setUnresponsive(true)
+	select {
+	case err := <-disconnected:
+		if !errors.Is(err, ErrPongTimeout) {
+			t.Fatalf("expected ErrPongTimeout, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the dead connection to be detected")
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reconnect")
+	}
+	if count := d.subscriptionCount("metrics"); count != 2 {
+		t.Fatalf("expected metrics to be subscribed twice, got %d", count)
+	}
+}
+
+func TestKeepaliveSlowHandler(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+	c.SetKeepalive(Keepalive{PingInterval: 20 * time.Millisecond, PongTimeout: 50 * time.Millisecond})
+
+	disconnected := make(chan error, 1)
+	c.OnDisconnect(func(err error) {
+		select {
+		case disconnected <- err:
+		default:
+		}
+	})
+
+	// The handler holds up the reader goroutine for several pong timeouts, so pongs aren't read until it returns
+	handled := make(chan struct{}, 1)
+	go func() {
+		_ = c.ListenSync(func(resp *types.WebsocketResponse, err error) {
+			time.Sleep(300 * time.Millisecond)
+			handled <- struct{}{}
+		})
+	}()
+	waitFor(t, func() bool {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return c.conn != nil
+	})
+	c.lock.Lock()
+	conn := c.conn
+	c.lock.Unlock()
+
+	d.broadcast("block", "chia_full_node", map[string]interface{}{})
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the handler")
+	}
+
+	// Pings keep being answered once the reader is back, so the connection should stay up
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case err := <-disconnected:
+		t.Fatalf("expected the connection to survive a slow handler, got %v", err)
+	default:
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn != conn {
+		t.Error("expected the connection to survive a slow handler")
+	}
+}
+
+func TestKeepaliveReadTimeout(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+	c.SetKeepalive(Keepalive{ReadTimeout: 50 * time.Millisecond})
+
+	disconnected := make(chan error, 1)
+	c.OnDisconnect(func(err error) {
+		select {
+		case disconnected <- err:
+		default:
+		}
+	})
+
+	request, err := c.NewRequest(rpcinterface.ServiceDaemon, "echo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Do(request, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-disconnected:
+		netErr, ok := err.(net.Error)
+		if !ok || !netErr.Timeout() {
+			t.Fatalf("expected a timeout error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the read deadline")
+	}
+}
@@ -0,0 +1,89 @@
+package websocketclient
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ReconnectPolicy decides whether, and how long to wait before, each attempt to reconnect a lost connection
+type ReconnectPolicy interface {
+	// NextDelay returns how long to wait before the reconnect attempt, starting at attempt 1
+	// Returning false gives up reconnecting
+	NextDelay(attempt int) (time.Duration, bool)
+}
+
+// ExponentialBackoff is a ReconnectPolicy that multiplies the delay after every failed attempt, up to MaxDelay
+type ExponentialBackoff struct {
+	// InitialDelay is the delay before the first attempt
+	InitialDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. 0 means no cap
+	MaxDelay time.Duration
+
+	// Multiplier is applied to the delay after every attempt. Values below 1 are treated as 1
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to this fraction of the delay in either direction, between 0 and 1
+	Jitter float64
+
+	// MaxAttempts is the number of attempts before giving up. 0 retries forever
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy returns the policy used when no reconnect policy is set
+// Retries forever, starting at 500ms and backing off to a maximum of 1 minute between attempts
+func DefaultReconnectPolicy() *ExponentialBackoff {
+	return &ExponentialBackoff{
+		InitialDelay: 500 * time.Millisecond,
+		MaxDelay:     time.Minute,
+		Multiplier:   2,
+		Jitter:       0.2,
+	}
+}
+
+var (
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitterLock sync.Mutex
+)
+
+// NextDelay returns the delay before the attempt, or false once MaxAttempts have been made
+func (b *ExponentialBackoff) NextDelay(attempt int) (time.Duration, bool) {
+	if b.MaxAttempts > 0 && attempt > b.MaxAttempts {
+		return 0, false
+	}
+
+	multiplier := math.Max(b.Multiplier, 1)
+	delay := float64(b.InitialDelay) * math.Pow(multiplier, float64(attempt-1))
+	if b.MaxDelay > 0 && delay > float64(b.MaxDelay) {
+		delay = float64(b.MaxDelay)
+	}
+
+	if b.Jitter > 0 {
+		jitterLock.Lock()
+		delay += delay * math.Min(b.Jitter, 1) * (jitterRand.Float64()*2 - 1)
+		jitterLock.Unlock()
+	}
+
+	return time.Duration(delay), true
+}
+
+// ReconnectError is returned from ListenSync when the reconnect policy gives up reconnecting
+type ReconnectError struct {
+	// Attempts is the number of reconnect attempts that were made
+	Attempts int
+
+	// Err is the error from the last attempt, or the error the connection was lost with if no attempt was made
+	Err error
+}
+
+func (e *ReconnectError) Error() string {
+	return fmt.Sprintf("gave up reconnecting after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error from the last attempt
+func (e *ReconnectError) Unwrap() error {
+	return e.Err
+}
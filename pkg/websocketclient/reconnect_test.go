@@ -0,0 +1,103 @@
+package websocketclient
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	policy := &ExponentialBackoff{
+		InitialDelay: time.Second,
+		MaxDelay:     5 * time.Second,
+		Multiplier:   2,
+		MaxAttempts:  5,
+	}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		delay, ok := policy.NextDelay(i + 1)
+		if !ok {
+			t.Fatalf("attempt %d: expected to retry", i+1)
+		}
+		if delay != want {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, want, delay)
+		}
+	}
+
+	if _, ok := policy.NextDelay(6); ok {
+		t.Error("expected to give up after MaxAttempts")
+	}
+}
+
+func TestExponentialBackoffJitter(t *testing.T) {
+	policy := &ExponentialBackoff{InitialDelay: time.Second, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		delay, _ := policy.NextDelay(1)
+		if delay < 500*time.Millisecond || delay > 1500*time.Millisecond {
+			t.Fatalf("expected delay within jitter range, got %s", delay)
+		}
+	}
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- c.ListenSync(func(resp *types.WebsocketResponse, err error) {})
+	}()
+	waitFor(t, func() bool {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return c.conn != nil
+	})
+
+	d.server.Close()
+	d.closeConnections()
+
+	select {
+	case err := <-listenErr:
+		reconnectErr := &ReconnectError{}
+		if !errors.As(err, &reconnectErr) {
+			t.Fatalf("expected *ReconnectError, got %v", err)
+		}
+		if reconnectErr.Attempts != 3 {
+			t.Errorf("expected 3 attempts, got %d", reconnectErr.Attempts)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for ListenSync to return")
+	}
+}
+
+func TestListenSyncAlreadyListening(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	// An error left over from a listener that returned without reading it must not end the next listener
+	c.listenErr <- &ReconnectError{Err: errors.New("stale")}
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- c.ListenSync(func(resp *types.WebsocketResponse, err error) {})
+	}()
+	waitFor(t, func() bool {
+		c.handlerLock.RLock()
+		defer c.handlerLock.RUnlock()
+		return c.handler != nil
+	})
+
+	if err := c.ListenSync(func(resp *types.WebsocketResponse, err error) {}); !errors.Is(err, ErrAlreadyListening) {
+		t.Fatalf("expected ErrAlreadyListening, got %v", err)
+	}
+
+	select {
+	case err := <-listenErr:
+		t.Fatalf("expected the first listener to keep listening, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
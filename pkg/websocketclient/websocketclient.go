@@ -1,12 +1,18 @@
 package websocketclient
 
 import (
+	"context"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/cmmarslender/go-chia-lib/pkg/config"
@@ -18,29 +24,84 @@ import (
 
 const origin string = "go-chia-rpc"
 
+// closeTimeout is the longest Close waits for the server to answer the close frame
+const closeTimeout = 5 * time.Second
+
+// ErrClientClosed is returned for requests made after Close, and from ListenSync once the client is closed
+var ErrClientClosed = errors.New("websocket client closed")
+
+// ErrAlreadyListening is returned from ListenSync when another call to ListenSync is already listening
+var ErrAlreadyListening = errors.New("websocket client is already listening")
+
+// errVerificationChanged closes connections that were verified with different settings than the client now uses
+var errVerificationChanged = errors.New("server certificate verification changed")
+
 // WebsocketClient connects to Chia RPC via websockets
+// It is safe for concurrent use. Each connection has a single goroutine reading from it and a single goroutine
+// writing to it, and requests are matched to their responses by request_id
 type WebsocketClient struct {
-	config  *config.ChiaConfig
 	baseURL *url.URL
 
-	daemonPort    uint16
-	daemonKeyPair *tls.Certificate
-	daemonDialer  *websocket.Dialer
+	// credentials provides the daemon port and key pair, loading them the first time the client connects
+	credentials rpcinterface.ServiceCredentials
+
+	// daemonDialer is created the first time the client connects, and again after tlsVerification changes
+	daemonDialer *websocket.Dialer
+
+	// tlsVerification configures how the server certificate is verified
+	tlsVerification rpcinterface.TLSVerification
+
+	// timeout is the longest Do will wait for a response. 0 waits until the context passed to DoWithContext is done
+	timeout time.Duration
+
+	// keepalive configures pings and read deadlines for new connections
+	keepalive Keepalive
 
-	conn *websocket.Conn
+	// reconnectPolicy decides how the client reconnects when the connection is lost
+	reconnectPolicy ReconnectPolicy
 
-	listenSyncActive bool
+	// disconnectHandlers and reconnectHandlers are called when the connection is lost, and once it is reestablished
+	disconnectHandlers []func(err error)
+	reconnectHandlers  []func()
+
+	// lock guards baseURL, daemonDialer, tlsVerification, timeout, keepalive, reconnectPolicy, the disconnect and
+	// reconnect handlers, conn and subscriptions
+	lock sync.Mutex
+
+	// conn is the current connection, or nil when not connected
+	conn *connection
+
+	// dialing allows only one goroutine at a time to dial a new connection
+	dialing chan struct{}
+
+	// handler is the ListenSync handler that receives everything that isn't a response to a pending request
+	handler     rpcinterface.WebsocketResponseHandler
+	handlerLock sync.RWMutex
+	listenErr   chan error
+
+	// closed is closed by Close, and stops reconnecting and any new connections
+	closed    chan struct{}
+	closeOnce sync.Once
 
 	// subscriptions Keeps track of subscribed topics, so we can re-subscribe if we lose a connection and reconnect
 	subscriptions []string
 }
 
 // NewWebsocketClient returns a new websocket client that satisfies the rpcinterface.Client interface
+// If cfg is nil, the chia config is loaded from CHIA_ROOT when the client first connects, unless the daemon port and
+// key pair were set with options
 func NewWebsocketClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOptionFunc) (*WebsocketClient, error) {
 	c := &WebsocketClient{
-		config: cfg,
+		timeout:         10 * time.Second,
+		keepalive:       DefaultKeepalive(),
+		reconnectPolicy: DefaultReconnectPolicy(),
 
-		daemonPort: cfg.DaemonPort,
+		dialing:   make(chan struct{}, 1),
+		listenErr: make(chan error, 1),
+		closed:    make(chan struct{}),
+	}
+	if cfg != nil {
+		c.credentials.SetConfig(cfg, "")
 	}
 
 	// Sets the default host. Can be overridden by client options
@@ -52,11 +113,6 @@ func NewWebsocketClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOp
 		return nil, err
 	}
 
-	err = c.initialKeyPairs()
-	if err != nil {
-		return nil, err
-	}
-
 	for _, fn := range options {
 		if fn == nil {
 			continue
@@ -66,26 +122,120 @@ func NewWebsocketClient(cfg *config.ChiaConfig, options ...rpcinterface.ClientOp
 		}
 	}
 
-	// Generate the http clients and transports after any client options are applied, in case custom keypairs were provided
-	err = c.generateDialer()
-	if err != nil {
-		return nil, err
-	}
-
 	return c, nil
 }
 
 // SetBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *WebsocketClient) SetBaseURL(url *url.URL) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	c.baseURL = url
 
 	return nil
 }
 
+// SetCAPool verifies the server certificate was issued by one of the CAs in the pool
+// If the client is already connected, the connection is closed and reestablished with the new verification
+func (c *WebsocketClient) SetCAPool(pool *x509.CertPool) {
+	c.updateVerification(func(v *rpcinterface.TLSVerification) {
+		v.CAPool = pool
+	})
+}
+
+// AddServerFingerprint pins the server certificate to a SHA-256 fingerprint
+// If the client is already connected, the connection is closed and reestablished with the new verification
+func (c *WebsocketClient) AddServerFingerprint(fingerprint []byte) {
+	c.updateVerification(func(v *rpcinterface.TLSVerification) {
+		v.Fingerprints = append(v.Fingerprints, fingerprint)
+	})
+}
+
+// updateVerification changes how the server certificate is verified, and rebuilds the dialer when next used
+// The current connection was verified with the old settings, so it is closed, failing any pending requests, and the
+// client reconnects according to the reconnect policy
+func (c *WebsocketClient) updateVerification(update func(v *rpcinterface.TLSVerification)) {
+	c.lock.Lock()
+	update(&c.tlsVerification)
+	c.daemonDialer = nil
+	conn := c.conn
+	c.conn = nil
+	c.lock.Unlock()
+
+	if conn != nil {
+		conn.close(errVerificationChanged)
+	}
+}
+
+// SetConfig sets the chia config that the daemon port and key pair are read from, when not set directly
+// Relative SSL paths in the config are relative to rootPath, or CHIA_ROOT if rootPath is empty
+func (c *WebsocketClient) SetConfig(cfg *config.ChiaConfig, rootPath string) {
+	c.credentials.SetConfig(cfg, rootPath)
+}
+
+// SetServicePort sets the port for the service, instead of reading it from the chia config
+// Only the daemon port is used, since every request goes through the daemon
+func (c *WebsocketClient) SetServicePort(service rpcinterface.ServiceType, port uint16) {
+	c.credentials.SetPort(service, port)
+}
+
+// SetServiceKeyPair sets the key pair for the service, instead of loading it from the paths in the chia config
+// Only the daemon key pair is used, since every request goes through the daemon
+func (c *WebsocketClient) SetServiceKeyPair(service rpcinterface.ServiceType, keyPair *tls.Certificate) {
+	c.credentials.SetKeyPair(service, keyPair)
+}
+
 // SetCacheValidTime sets how long cache should be valid for
 // This is not currently supported by the websocket client
 func (c *WebsocketClient) SetCacheValidTime(validTime time.Duration) {}
 
+// SetTimeout sets the maximum time Do will wait for a response
+// Set to 0 to rely solely on the context passed to DoWithContext
+func (c *WebsocketClient) SetTimeout(timeout time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.timeout = timeout
+}
+
+// SetKeepalive sets how connections detect that the server has stopped responding
+// A dead connection is closed, and reconnected according to the reconnect policy
+// Applies to connections made after it is called
+func (c *WebsocketClient) SetKeepalive(keepalive Keepalive) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.keepalive = keepalive
+}
+
+// SetReconnectPolicy sets the policy used to reconnect when the connection is lost
+// A nil policy disables reconnecting
+func (c *WebsocketClient) SetReconnectPolicy(policy ReconnectPolicy) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.reconnectPolicy = policy
+}
+
+// OnDisconnect registers a function that is called with the error the connection was lost with
+// Requests waiting on a response have already failed by the time it is called, and events may be missed until
+// the connection is reestablished
+func (c *WebsocketClient) OnDisconnect(handler func(err error)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.disconnectHandlers = append(c.disconnectHandlers, handler)
+}
+
+// OnReconnect registers a function that is called once a lost connection is reestablished and subscriptions
+// have been registered again
+func (c *WebsocketClient) OnReconnect(handler func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.reconnectHandlers = append(c.reconnectHandlers, handler)
+}
+
 // NewRequest creates an RPC request for the specified service
 func (c *WebsocketClient) NewRequest(service rpcinterface.ServiceType, rpcEndpoint rpcinterface.Endpoint, opt interface{}) (*rpcinterface.Request, error) {
 	request := &rpcinterface.Request{
@@ -98,154 +248,529 @@ func (c *WebsocketClient) NewRequest(service rpcinterface.ServiceType, rpcEndpoi
 	return request, nil
 }
 
-// Do sends an RPC request via the websocket
+// Do sends an RPC request via the websocket and waits for the matching response
 // *http.Response is always nil in this return, and exists to satisfy the interface that existed prior to
 // websockets being supported in this library
 func (c *WebsocketClient) Do(req *rpcinterface.Request, v interface{}) (*http.Response, error) {
-	err := c.ensureConnection()
-	if err != nil {
-		return nil, err
+	return c.DoWithContext(context.Background(), req, v)
+}
+
+// DoWithContext sends an RPC request via the websocket and waits for the response with the same request_id
+// The response data is decoded into v. Waiting is aborted when ctx is done or the client timeout is reached
+func (c *WebsocketClient) DoWithContext(ctx context.Context, req *rpcinterface.Request, v interface{}) (*http.Response, error) {
+	if c.isClosed() {
+		return nil, ErrClientClosed
 	}
 
-	var destination string
-	switch req.Service {
-	case rpcinterface.ServiceDaemon:
-		destination = "daemon"
-	case rpcinterface.ServiceFullNode:
-		destination = "chia_full_node"
-	case rpcinterface.ServiceFarmer:
-		destination = "chia_farmer" // @TODO validate the correct string for this
-	case rpcinterface.ServiceHarvester:
-		destination = "chia_harvester" // @TODO validate the correct string for this
-	case rpcinterface.ServiceWallet:
-		destination = "chia_wallet"
-	case rpcinterface.ServiceCrawler:
-		destination = "chia_crawler"
-	default:
-		return nil, fmt.Errorf("unknown service")
+	destination, err := destinationForService(req.Service)
+	if err != nil {
+		return nil, err
 	}
 
 	data := req.Data
 	if data == nil {
 		data = map[string]interface{}{}
 	}
+	requestID, err := generateRequestID()
+	if err != nil {
+		return nil, err
+	}
 	request := &types.WebsocketRequest{
 		Command:     string(req.Endpoint),
 		Origin:      origin,
 		Destination: destination,
+		RequestID:   requestID,
 		Data:        data,
 	}
 
-	return nil, c.conn.WriteJSON(request)
+	c.lock.Lock()
+	timeout := c.timeout
+	c.lock.Unlock()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Responses from services other than the daemon are only routed back to us if our origin is registered
+	if req.Service != rpcinterface.ServiceDaemon && !c.isSubscribed(origin) {
+		err = c.SubscribeSelfWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := c.ensureConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, c.roundTrip(ctx, conn, req, request, v)
+}
+
+// roundTrip writes the request to the connection and waits for the response with the same request_id
+func (c *WebsocketClient) roundTrip(ctx context.Context, conn *connection, req *rpcinterface.Request, request *types.WebsocketRequest, v interface{}) error {
+	resultChan, err := conn.addPending(request.RequestID)
+	if err != nil {
+		return err
+	}
+	defer conn.removePending(request.RequestID)
+
+	err = conn.write(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	var result pendingResult
+	select {
+	case result = <-resultChan:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if result.err != nil {
+		return result.err
+	}
+
+	err = rpcinterface.CheckResponse(req.Service, req.Endpoint, 0, result.resp.Data)
+	if err != nil {
+		return err
+	}
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = w.Write(result.resp.Data)
+		} else {
+			err = json.Unmarshal(result.resp.Data, v)
+		}
+	}
+
+	return err
+}
+
+// destinationForService returns the name the daemon uses to route messages to the service
+func destinationForService(service rpcinterface.ServiceType) (string, error) {
+	switch service {
+	case rpcinterface.ServiceDaemon:
+		return "daemon", nil
+	case rpcinterface.ServiceFullNode:
+		return "chia_full_node", nil
+	case rpcinterface.ServiceFarmer:
+		return "chia_farmer", nil // @TODO validate the correct string for this
+	case rpcinterface.ServiceHarvester:
+		return "chia_harvester", nil // @TODO validate the correct string for this
+	case rpcinterface.ServiceWallet:
+		return "chia_wallet", nil
+	case rpcinterface.ServiceCrawler:
+		return "chia_crawler", nil
+	case rpcinterface.ServiceDataLayer:
+		return "chia_data_layer", nil
+	}
+
+	return "", fmt.Errorf("unknown service")
+}
+
+// generateRequestID returns a random hex request ID, in the same format chia uses
+func generateRequestID() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
 }
 
 // SubscribeSelf calls subscribe for any requests that this client makes to the server
 // Different from Subscribe with a custom service - that is more for subscribing to built in events emitted by Chia
 // This call will subscribe `go-chia-rpc` origin for any requests we specifically make of the server
 func (c *WebsocketClient) SubscribeSelf() error {
-	return c.Subscribe(origin)
+	return c.SubscribeSelfWithContext(context.Background())
+}
+
+// SubscribeSelfWithContext is the same as SubscribeSelf, but the request is bound to ctx
+func (c *WebsocketClient) SubscribeSelfWithContext(ctx context.Context) error {
+	return c.SubscribeWithContext(ctx, origin)
 }
 
 // Subscribe adds a subscription to a particular service
 func (c *WebsocketClient) Subscribe(service string) error {
+	return c.SubscribeWithContext(context.Background(), service)
+}
+
+// SubscribeWithContext is the same as Subscribe, but the request is bound to ctx
+func (c *WebsocketClient) SubscribeWithContext(ctx context.Context, service string) error {
+	if c.isSubscribed(service) {
+		return nil
+	}
+
+	err := c.doSubscribe(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Another goroutine may have subscribed to the same service while we were
+	for _, subscription := range c.subscriptions {
+		if subscription == service {
+			return nil
+		}
+	}
 	c.subscriptions = append(c.subscriptions, service)
 
-	return c.doSubscribe(service)
+	return nil
 }
 
-func (c *WebsocketClient) doSubscribe(service string) error {
+func (c *WebsocketClient) doSubscribe(ctx context.Context, service string) error {
 	request, err := c.NewRequest(rpcinterface.ServiceDaemon, "register_service", types.WebsocketSubscription{Service: service})
 	if err != nil {
 		return err
 	}
 
-	_, err = c.Do(request, nil)
+	_, err = c.DoWithContext(ctx, request, nil)
 	return err
 }
 
+// isSubscribed returns true if there is already a subscription to the service
+func (c *WebsocketClient) isSubscribed(service string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, subscription := range c.subscriptions {
+		if subscription == service {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ListenSync Listens for responses over the websocket connection in the foreground
-// The error returned from this function would only correspond to an error setting up the listener
-// Errors returned by ReadMessage, or some other part of the websocket request/response will be
-// passed to the handler to deal with
+// Responses to requests made with Do are returned from Do, and are not passed to the handler
+// The handler is called from the goroutine reading the connection, so it must not call Do itself
+// Blocks until the reconnect policy gives up on a lost connection, and returns a *ReconnectError,
+// or until the client is closed, and returns ErrClientClosed
+// Only one call may listen at a time. Others return ErrAlreadyListening
 func (c *WebsocketClient) ListenSync(handler rpcinterface.WebsocketResponseHandler) error {
-	if c.listenSyncActive != true {
-		c.listenSyncActive = true
-
-		for {
-			_, message, err := c.conn.ReadMessage()
-			if err != nil {
-				if closeErr, isCloseErr := err.(*websocket.CloseError); isCloseErr {
-					log.Println(closeErr.Error())
-					c.conn = nil
-					c.reconnectLoop()
-					continue
-				}
-				c.listenSyncActive = false
-				return err
-			}
-			resp := &types.WebsocketResponse{}
-			err = json.Unmarshal(message, resp)
-			handler(resp, err)
-		}
+	c.handlerLock.Lock()
+	if c.handler != nil {
+		c.handlerLock.Unlock()
+		return ErrAlreadyListening
 	}
+	// An error is only sent while a handler is set, so anything left over is from a listener that has already
+	// returned, and isn't about this one
+	select {
+	case <-c.listenErr:
+	default:
+	}
+	c.handler = handler
+	c.handlerLock.Unlock()
 
-	return nil
+	defer func() {
+		c.handlerLock.Lock()
+		c.handler = nil
+		c.handlerLock.Unlock()
+	}()
+
+	_, err := c.ensureConnection(context.Background())
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err = <-c.listenErr:
+		return err
+	case <-c.closed:
+		return ErrClientClosed
+	}
 }
 
-func (c *WebsocketClient) reconnectLoop() {
+// readLoop reads every message from the connection, and routes it to the pending request with the same
+// request_id or to the ListenSync handler if it isn't a response to a request we're waiting for
+// This is the only goroutine that reads from the connection
+func (c *WebsocketClient) readLoop(conn *connection) {
 	for {
-		log.Println("Trying to reconnect...")
-		err := c.ensureConnection()
-		if err == nil {
-			log.Println("Reconnected!")
-			for _, topic := range c.subscriptions {
-				c.doSubscribe(topic)
-			}
+		conn.startReading()
+		_, message, err := conn.ws.ReadMessage()
+		conn.stopReading()
+		if err != nil {
+			conn.close(err)
+			c.connectionLost(conn, conn.closeErr())
+			return
+		}
+		err = conn.extendReadDeadline()
+		if err != nil {
+			conn.close(err)
+			c.connectionLost(conn, conn.closeErr())
 			return
 		}
 
-		log.Printf("Unable to reconnect: %s\n", err.Error())
-		time.Sleep(5 * time.Second)
+		resp := &types.WebsocketResponse{}
+		err = json.Unmarshal(message, resp)
+		if err == nil && resp.RequestID != "" && conn.resolvePending(resp) {
+			continue
+		}
+
+		c.handlerLock.RLock()
+		if c.handler != nil {
+			c.handler(resp, err)
+		}
+		c.handlerLock.RUnlock()
 	}
 }
 
-// Sets the initial key pairs based on config
-func (c *WebsocketClient) initialKeyPairs() error {
-	var err error
+// connectionLost clears the failed connection and reconnects according to the reconnect policy
+// If the policy gives up, the error is returned from ListenSync
+func (c *WebsocketClient) connectionLost(conn *connection, err error) {
+	c.lock.Lock()
+	if c.conn == conn {
+		c.conn = nil
+	}
+	disconnectHandlers := c.disconnectHandlers
+	c.lock.Unlock()
+
+	if c.isClosed() {
+		return
+	}
 
-	c.daemonKeyPair, err = c.config.DaemonSSL.LoadPrivateKeyPair()
+	for _, handler := range disconnectHandlers {
+		handler(err)
+	}
+
+	err = c.reconnectLoop(err)
 	if err != nil {
-		return err
+		c.handlerLock.RLock()
+		if c.handler != nil {
+			select {
+			case c.listenErr <- err:
+			default:
+			}
+		}
+		c.handlerLock.RUnlock()
 	}
+}
 
-	return nil
+// reconnectLoop reconnects and resubscribes, waiting between attempts as long as the reconnect policy says to
+// Returns a *ReconnectError if the policy gives up
+func (c *WebsocketClient) reconnectLoop(lostErr error) error {
+	c.lock.Lock()
+	policy := c.reconnectPolicy
+	c.lock.Unlock()
+
+	if policy == nil {
+		return &ReconnectError{Err: lostErr}
+	}
+
+	lastErr := lostErr
+	for attempt := 1; ; attempt++ {
+		delay, ok := policy.NextDelay(attempt)
+		if !ok {
+			return &ReconnectError{Attempts: attempt - 1, Err: lastErr}
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-c.closed:
+			timer.Stop()
+			return ErrClientClosed
+		}
+
+		_, err := c.ensureConnection(context.Background())
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		c.resubscribe()
+
+		c.lock.Lock()
+		reconnectHandlers := c.reconnectHandlers
+		c.lock.Unlock()
+		for _, handler := range reconnectHandlers {
+			handler()
+		}
+
+		return nil
+	}
 }
 
-func (c *WebsocketClient) generateDialer() error {
+// resubscribe registers every subscription again on a new connection
+func (c *WebsocketClient) resubscribe() {
+	c.lock.Lock()
+	subscriptions := make([]string, len(c.subscriptions))
+	copy(subscriptions, c.subscriptions)
+	c.lock.Unlock()
+
+	for _, topic := range subscriptions {
+		_ = c.doSubscribe(context.Background(), topic)
+	}
+}
+
+// dialer returns the dialer for the daemon, loading the daemon key pair the first time it is needed
+func (c *WebsocketClient) dialer() (*websocket.Dialer, error) {
+	keyPair, err := c.credentials.KeyPair(rpcinterface.ServiceDaemon)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	if c.daemonDialer == nil {
 		c.daemonDialer = &websocket.Dialer{
 			Proxy:            http.ProxyFromEnvironment,
 			HandshakeTimeout: 45 * time.Second,
-			TLSClientConfig: &tls.Config{
-				Certificates:       []tls.Certificate{*c.daemonKeyPair},
-				InsecureSkipVerify: true,
-			},
+			TLSClientConfig:  c.tlsVerification.TLSConfig(keyPair),
 		}
 	}
 
-	return nil
+	return c.daemonDialer, nil
 }
 
-// ensureConnection ensures there is an open websocket connection
-func (c *WebsocketClient) ensureConnection() error {
-	if c.conn == nil {
-		u := url.URL{Scheme: "wss", Host: fmt.Sprintf("%s:%d", c.baseURL.Host, c.daemonPort), Path: "/"}
-		var err error
-		c.conn, _, err = c.daemonDialer.Dial(u.String(), nil)
-		if err != nil {
-			return err
+// ensureConnection returns the open websocket connection, dialing a new one if there isn't one
+func (c *WebsocketClient) ensureConnection(ctx context.Context) (*connection, error) {
+	if c.isClosed() {
+		return nil, ErrClientClosed
+	}
+
+	c.lock.Lock()
+	conn := c.conn
+	c.lock.Unlock()
+	if conn != nil {
+		return conn, nil
+	}
+
+	select {
+	case c.dialing <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.closed:
+		return nil, ErrClientClosed
+	}
+	defer func() { <-c.dialing }()
+
+	// Another goroutine may have connected while we were waiting to dial
+	c.lock.Lock()
+	conn = c.conn
+	host := c.baseURL.Host
+	keepalive := c.keepalive
+	c.lock.Unlock()
+	if conn != nil {
+		return conn, nil
+	}
+
+	port, err := c.credentials.Port(rpcinterface.ServiceDaemon)
+	if err != nil {
+		return nil, err
+	}
+	dialer, err := c.dialer()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Scheme: "wss", Host: fmt.Sprintf("%s:%d", host, port), Path: "/"}
+	ws, _, err := dialer.DialContext(ctx, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn = newConnection(ws, keepalive)
+	err = conn.extendReadDeadline()
+	if err != nil {
+		_ = ws.Close()
+		return nil, err
+	}
+
+	// Close may have run while dialing, and wouldn't have seen this connection to close it
+	c.lock.Lock()
+	if c.isClosed() {
+		c.lock.Unlock()
+		_ = ws.Close()
+		return nil, ErrClientClosed
+	}
+	// The verification may also have changed while dialing, after the connection was verified with the old settings
+	if c.daemonDialer != dialer {
+		c.lock.Unlock()
+		_ = ws.Close()
+		return nil, errVerificationChanged
+	}
+	c.conn = conn
+	c.lock.Unlock()
+
+	go conn.writeLoop()
+	go c.readLoop(conn)
+
+	return conn, nil
+}
+
+// isClosed returns true once Close has been called
+func (c *WebsocketClient) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close unregisters every subscription, closes the connection with a close frame and stops reconnecting
+// Requests waiting on a response fail, and ListenSync returns ErrClientClosed
+// ctx bounds how long Close waits to unregister and for the server to answer the close frame
+func (c *WebsocketClient) Close(ctx context.Context) error {
+	var conn *connection
+	var subscriptions []string
+	c.closeOnce.Do(func() {
+		c.lock.Lock()
+		close(c.closed)
+		conn = c.conn
+		subscriptions = make([]string, len(c.subscriptions))
+		copy(subscriptions, c.subscriptions)
+		c.lock.Unlock()
+	})
+	if conn == nil {
+		return nil
+	}
+
+	// Unregistering is best effort, since the connection is closing either way
+	for _, service := range subscriptions {
+		if ctx.Err() != nil {
+			break
 		}
+		_ = c.unsubscribe(ctx, conn, service)
 	}
 
-	return nil
+	err := conn.writeClose(ctx)
+	if err == nil {
+		timer := time.NewTimer(closeTimeout)
+		select {
+		case <-conn.done:
+		case <-timer.C:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		timer.Stop()
+	}
+	conn.close(ErrClientClosed)
+
+	return err
+}
+
+// unsubscribe sends unregister_service for the service on the connection
+func (c *WebsocketClient) unsubscribe(ctx context.Context, conn *connection, service string) error {
+	requestID, err := generateRequestID()
+	if err != nil {
+		return err
+	}
+
+	req := &rpcinterface.Request{Service: rpcinterface.ServiceDaemon, Endpoint: "unregister_service"}
+	request := &types.WebsocketRequest{
+		Command:     string(req.Endpoint),
+		Origin:      origin,
+		Destination: "daemon",
+		RequestID:   requestID,
+		Data:        types.WebsocketSubscription{Service: service},
+	}
+
+	return c.roundTrip(ctx, conn, req, request, nil)
 }
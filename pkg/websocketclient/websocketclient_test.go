@@ -0,0 +1,692 @@
+package websocketclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cmmarslender/go-chia-lib/pkg/config"
+	"github.com/gorilla/websocket"
+
+	"github.com/cmmarslender/go-chia-rpc/internal/testutil"
+	"github.com/cmmarslender/go-chia-rpc/pkg/rpcinterface"
+	"github.com/cmmarslender/go-chia-rpc/pkg/types"
+)
+
+// fakeDaemon is a websocket server that answers requests the way the chia daemon does
+// Every request is answered with its own data echoed back, plus success: true, except "hang" which is never answered
+type fakeDaemon struct {
+	t      *testing.T
+	server *httptest.Server
+
+	lock            sync.Mutex
+	conns           []*websocket.Conn
+	writeLocks      map[*websocket.Conn]*sync.Mutex
+	subscriptions   []string
+	unsubscriptions []string
+	closeCodes      []int
+
+	// unresponsive stops the daemon answering pings, as if the connection was half open
+	unresponsive bool
+
+	// beforeUpgrade is called before each connection is upgraded to a websocket, if set
+	beforeUpgrade func()
+
+	// hangSubscriptions stops the daemon answering register_service
+	hangSubscriptions bool
+}
+
+func newFakeDaemon(t *testing.T) *fakeDaemon {
+	d := &fakeDaemon{
+		t:          t,
+		writeLocks: map[*websocket.Conn]*sync.Mutex{},
+	}
+	upgrader := websocket.Upgrader{}
+	d.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.lock.Lock()
+		beforeUpgrade := d.beforeUpgrade
+		d.lock.Unlock()
+		if beforeUpgrade != nil {
+			beforeUpgrade()
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.SetPingHandler(func(data string) error {
+			d.lock.Lock()
+			unresponsive := d.unresponsive
+			d.lock.Unlock()
+			if unresponsive {
+				return nil
+			}
+
+			return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+		})
+		d.lock.Lock()
+		d.conns = append(d.conns, conn)
+		d.writeLocks[conn] = &sync.Mutex{}
+		d.lock.Unlock()
+
+		d.serve(conn)
+	}))
+	t.Cleanup(d.server.Close)
+
+	return d
+}
+
+func (d *fakeDaemon) serve(conn *websocket.Conn) {
+	for {
+		request := &types.WebsocketRequest{}
+		err := conn.ReadJSON(request)
+		if err != nil {
+			if closeErr, ok := err.(*websocket.CloseError); ok {
+				d.lock.Lock()
+				d.closeCodes = append(d.closeCodes, closeErr.Code)
+				d.lock.Unlock()
+			}
+			return
+		}
+		if request.Command == "hang" {
+			continue
+		}
+		d.lock.Lock()
+		hangSubscriptions := d.hangSubscriptions
+		d.lock.Unlock()
+		if request.Command == "register_service" && hangSubscriptions {
+			continue
+		}
+
+		data := map[string]interface{}{}
+		if raw, err := json.Marshal(request.Data); err == nil {
+			_ = json.Unmarshal(raw, &data)
+		}
+		switch request.Command {
+		case "register_service":
+			d.lock.Lock()
+			d.subscriptions = append(d.subscriptions, data["service"].(string))
+			d.lock.Unlock()
+		case "unregister_service":
+			d.lock.Lock()
+			d.unsubscriptions = append(d.unsubscriptions, data["service"].(string))
+			d.lock.Unlock()
+		}
+		data["success"] = true
+
+		d.send(conn, request.Command, request.Destination, request.RequestID, data)
+	}
+}
+
+func (d *fakeDaemon) send(conn *websocket.Conn, command string, from string, requestID string, data interface{}) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		d.t.Error(err)
+		return
+	}
+
+	d.lock.Lock()
+	writeLock := d.writeLocks[conn]
+	d.lock.Unlock()
+
+	writeLock.Lock()
+	defer writeLock.Unlock()
+	_ = conn.WriteJSON(&types.WebsocketResponse{
+		Command:     command,
+		Origin:      from,
+		Destination: "metrics",
+		RequestID:   requestID,
+		Data:        raw,
+	})
+}
+
+// broadcast sends an event to every open connection
+func (d *fakeDaemon) broadcast(command string, from string, data interface{}) {
+	d.lock.Lock()
+	conns := make([]*websocket.Conn, len(d.conns))
+	copy(conns, d.conns)
+	d.lock.Unlock()
+
+	for _, conn := range conns {
+		d.send(conn, command, from, "", data)
+	}
+}
+
+// closeConnections closes every open connection with a close frame, like the daemon does when it shuts down
+func (d *fakeDaemon) closeConnections() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, conn := range d.conns {
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(time.Second))
+		_ = conn.Close()
+	}
+	d.conns = nil
+}
+
+func (d *fakeDaemon) setUnresponsive(unresponsive bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.unresponsive = unresponsive
+}
+
+func (d *fakeDaemon) subscriptionCount(service string) int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	count := 0
+	for _, subscription := range d.subscriptions {
+		if subscription == service {
+			count++
+		}
+	}
+
+	return count
+}
+
+// newReversingDaemon returns a fake daemon that waits for count requests, then answers them in reverse order
+// Every request is answered with its own data echoed back, plus success: true
+func newReversingDaemon(t *testing.T, count int) *fakeDaemon {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var requests []*types.WebsocketRequest
+		for len(requests) < count {
+			request := &types.WebsocketRequest{}
+			err = conn.ReadJSON(request)
+			if err != nil {
+				return
+			}
+			requests = append(requests, request)
+		}
+
+		for i := len(requests) - 1; i >= 0; i-- {
+			data := map[string]interface{}{}
+			if raw, err := json.Marshal(requests[i].Data); err == nil {
+				_ = json.Unmarshal(raw, &data)
+			}
+			data["success"] = true
+			raw, err := json.Marshal(data)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			err = conn.WriteJSON(&types.WebsocketResponse{
+				Command:     requests[i].Command,
+				Origin:      requests[i].Destination,
+				Destination: origin,
+				RequestID:   requests[i].RequestID,
+				Data:        raw,
+			})
+			if err != nil {
+				return
+			}
+		}
+
+		// Hold the connection open until the client is done with it
+		_, _, _ = conn.ReadMessage()
+	}))
+	t.Cleanup(server.Close)
+
+	return &fakeDaemon{t: t, server: server}
+}
+
+// newTestClient returns a websocket client connected to the fake daemon, using a chia root in a temp directory
+func newTestClient(t *testing.T, d *fakeDaemon, options ...rpcinterface.ClientOptionFunc) *WebsocketClient {
+	root := t.TempDir()
+	testutil.WriteKeyPair(t, filepath.Join(root, "daemon.crt"), filepath.Join(root, "daemon.key"))
+	host, daemonPort := testutil.ServerAddress(t, d.server)
+
+	cfg := &config.ChiaConfig{
+		DaemonPort: daemonPort,
+		DaemonSSL: config.SSLConfig{
+			PrivateCRT: "daemon.crt",
+			PrivateKey: "daemon.key",
+		},
+	}
+	options = append([]rpcinterface.ClientOptionFunc{func(c rpcinterface.Client) error {
+		c.SetConfig(cfg, root)
+		return c.SetBaseURL(&url.URL{Scheme: "wss", Host: host})
+	}}, options...)
+	client, err := NewWebsocketClient(nil, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetReconnectPolicy(&ExponentialBackoff{InitialDelay: 10 * time.Millisecond, Multiplier: 2, MaxAttempts: 3})
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = client.Close(ctx)
+	})
+
+	return client
+}
+
+type echoResponse struct {
+	rpcinterface.Response
+	Index int `json:"index"`
+}
+
+func doEcho(c *WebsocketClient, service rpcinterface.ServiceType, index int) error {
+	request, err := c.NewRequest(service, "echo", map[string]interface{}{"index": index})
+	if err != nil {
+		return err
+	}
+
+	r := &echoResponse{}
+	_, err = c.Do(request, r)
+	if err != nil {
+		return err
+	}
+	if r.Index != index {
+		return fmt.Errorf("request %d received the response for request %d", index, r.Index)
+	}
+
+	return nil
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestConcurrentDo(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			service := rpcinterface.ServiceFullNode
+			if i%2 == 0 {
+				service = rpcinterface.ServiceWallet
+			}
+			errs <- doEcho(c, service, i)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if count := d.subscriptionCount(origin); count < 1 {
+		t.Errorf("expected %s to be subscribed", origin)
+	}
+}
+
+func TestOutOfOrderResponses(t *testing.T) {
+	const count = 5
+	c := newTestClient(t, newReversingDaemon(t, count))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- doEcho(c, rpcinterface.ServiceDaemon, i)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestConcurrentSubscribeAndListen(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	received := make(chan *types.WebsocketResponse, 10)
+	go func() {
+		_ = c.ListenSync(func(resp *types.WebsocketResponse, err error) {
+			if err == nil {
+				received <- resp
+			}
+		})
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := c.Subscribe("metrics"); err != nil {
+				t.Error(err)
+			}
+			if err := doEcho(c, rpcinterface.ServiceDaemon, i); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if !c.isSubscribed("metrics") {
+		t.Fatal("expected to be subscribed to metrics")
+	}
+
+	waitFor(t, func() bool {
+		c.handlerLock.RLock()
+		defer c.handlerLock.RUnlock()
+		return c.handler != nil
+	})
+	d.broadcast("block", "chia_full_node", map[string]interface{}{"height": 10})
+
+	select {
+	case resp := <-received:
+		if resp.Command != "block" {
+			t.Errorf("expected block event, got %s", resp.Command)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestReconnectResubscribes(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	disconnected := make(chan error, 1)
+	reconnected := make(chan struct{}, 1)
+	c.OnDisconnect(func(err error) {
+		disconnected <- err
+	})
+	c.OnReconnect(func() {
+		reconnected <- struct{}{}
+	})
+
+	if err := c.Subscribe("metrics"); err != nil {
+		t.Fatal(err)
+	}
+
+	d.closeConnections()
+	select {
+	case <-disconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for disconnect")
+	}
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reconnect")
+	}
+	if count := d.subscriptionCount("metrics"); count != 2 {
+		t.Fatalf("expected metrics to be subscribed twice, got %d", count)
+	}
+
+	if err := doEcho(c, rpcinterface.ServiceDaemon, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDoWithContextCancel(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	request, err := c.NewRequest(rpcinterface.ServiceDaemon, "hang", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err = c.DoWithContext(ctx, request, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	c.SetTimeout(50 * time.Millisecond)
+	_, err = c.Do(request, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	// Abandoned requests must not be left waiting on a response
+	c.lock.Lock()
+	conn := c.conn
+	c.lock.Unlock()
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	if len(conn.pending) != 0 {
+		t.Errorf("expected no pending requests, got %d", len(conn.pending))
+	}
+}
+
+func TestDoWithContextSubscribeSelf(t *testing.T) {
+	d := newFakeDaemon(t)
+	d.lock.Lock()
+	d.hangSubscriptions = true
+	d.lock.Unlock()
+	c := newTestClient(t, d)
+
+	// The first request to the full node registers the client with the daemon, which has to respect the context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	request, err := c.NewRequest(rpcinterface.ServiceFullNode, "get_blockchain_state", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = c.DoWithContext(ctx, request, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected registering with the daemon to be aborted at the context deadline, took %s", elapsed)
+	}
+}
+
+func TestClose(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	if err := c.Subscribe("metrics"); err != nil {
+		t.Fatal(err)
+	}
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- c.ListenSync(func(resp *types.WebsocketResponse, err error) {})
+	}()
+
+	// A request the daemon never answers should fail when the client is closed
+	doErr := make(chan error, 1)
+	go func() {
+		request, err := c.NewRequest(rpcinterface.ServiceDaemon, "hang", nil)
+		if err != nil {
+			doErr <- err
+			return
+		}
+		_, err = c.DoWithContext(context.Background(), request, nil)
+		doErr <- err
+	}()
+	waitFor(t, func() bool {
+		c.lock.Lock()
+		conn := c.conn
+		c.lock.Unlock()
+		if conn == nil {
+			return false
+		}
+		conn.lock.Lock()
+		defer conn.lock.Unlock()
+		return len(conn.pending) == 1
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-doErr:
+		if err == nil {
+			t.Fatal("expected the pending request to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the pending request to fail")
+	}
+	select {
+	case err := <-listenErr:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed from ListenSync, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for ListenSync to return")
+	}
+
+	waitFor(t, func() bool {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		return len(d.closeCodes) == 1
+	})
+	d.lock.Lock()
+	unsubscriptions := d.unsubscriptions
+	closeCode := d.closeCodes[0]
+	d.lock.Unlock()
+	if len(unsubscriptions) != 1 || unsubscriptions[0] != "metrics" {
+		t.Errorf("expected metrics to be unregistered, got %v", unsubscriptions)
+	}
+	if closeCode != websocket.CloseNormalClosure {
+		t.Errorf("expected a normal closure, got %d", closeCode)
+	}
+
+	if err := doEcho(c, rpcinterface.ServiceDaemon, 1); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("expected ErrClientClosed after Close, got %v", err)
+	}
+}
+
+func TestCloseDuringDial(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	// Hold the handshake until the client has been closed
+	upgrading := make(chan struct{})
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	t.Cleanup(func() { releaseOnce.Do(func() { close(release) }) })
+	d.lock.Lock()
+	d.beforeUpgrade = func() {
+		close(upgrading)
+		<-release
+	}
+	d.lock.Unlock()
+
+	doErr := make(chan error, 1)
+	go func() {
+		doErr <- doEcho(c, rpcinterface.ServiceDaemon, 1)
+	}()
+	select {
+	case <-upgrading:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the client to dial")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	releaseOnce.Do(func() { close(release) })
+
+	select {
+	case err := <-doErr:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed for a connection dialed during Close, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the request to fail")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn != nil {
+		t.Error("expected the connection dialed during Close to be discarded")
+	}
+}
+
+func TestServerFingerprint(t *testing.T) {
+	d := newFakeDaemon(t)
+	fingerprint := sha256.Sum256(d.server.Certificate().Raw)
+	wrongFingerprint := sha256.Sum256([]byte("not the server certificate"))
+
+	pinned := newTestClient(t, d, func(c rpcinterface.Client) error {
+		c.AddServerFingerprint(fingerprint[:])
+		return nil
+	})
+	if err := doEcho(pinned, rpcinterface.ServiceDaemon, 1); err != nil {
+		t.Fatalf("expected the pinned certificate to be accepted, got %v", err)
+	}
+
+	notPinned := newTestClient(t, d, func(c rpcinterface.Client) error {
+		c.AddServerFingerprint(wrongFingerprint[:])
+		return nil
+	})
+	if err := doEcho(notPinned, rpcinterface.ServiceDaemon, 1); err == nil {
+		t.Fatal("expected a certificate that isn't pinned to be rejected")
+	}
+
+	// The test server's certificate is not issued by the CA, or for chia.net
+	otherCA := x509.NewCertPool()
+	otherCA.AddCert(d.server.Certificate())
+	wrongCA := newTestClient(t, d, func(c rpcinterface.Client) error {
+		c.SetCAPool(otherCA)
+		return nil
+	})
+	if err := doEcho(wrongCA, rpcinterface.ServiceDaemon, 1); err == nil {
+		t.Fatal("expected a certificate not issued for chia.net to be rejected")
+	}
+}
+
+func TestServerFingerprintAfterConnecting(t *testing.T) {
+	d := newFakeDaemon(t)
+	c := newTestClient(t, d)
+
+	if err := doEcho(c, rpcinterface.ServiceDaemon, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// The connection was dialed without verification, so pinning a certificate has to replace it
+	wrongFingerprint := sha256.Sum256([]byte("not the server certificate"))
+	c.AddServerFingerprint(wrongFingerprint[:])
+	if err := doEcho(c, rpcinterface.ServiceDaemon, 2); err == nil {
+		t.Fatal("expected requests after pinning another certificate to be rejected")
+	}
+
+	fingerprint := sha256.Sum256(d.server.Certificate().Raw)
+	c.AddServerFingerprint(fingerprint[:])
+	if err := doEcho(c, rpcinterface.ServiceDaemon, 3); err != nil {
+		t.Fatalf("expected the pinned certificate to be accepted, got %v", err)
+	}
+}